@@ -1,11 +1,15 @@
 package main
 
 import (
+	"bytes"
 	"log"
+	"os"
 	"regexp"
 	"strings"
 	"text/template"
 
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
 
 	"github.com/sorbet/protoc-gen-rbi/ruby_types"
@@ -18,16 +22,41 @@ var (
 	validRubyField = regexp.MustCompile(`\A[a-z][A-Za-z0-9_]*\z`)
 )
 
-var SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+var SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL | pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+
+var (
+	MinimumEdition = descriptorpb.Edition_EDITION_PROTO2
+	MaximumEdition = descriptorpb.Edition_EDITION_2023
+)
+
+// major versions of google-protobuf the generated RBI can target. 4 also
+// converts an Array assigned to a repeated field, and its to_h leaves out every
+// field that isn't set. Both define `has_<field>?` for the same fields, take
+// the same JSON options and freeze messages the same way.
+const (
+	MinimumRuntimeVersion = 3
+	MaximumRuntimeVersion = 4
+)
 
 type rbiModule struct {
 	*pgs.ModuleBase
 	ctx                       pgsgo.Context
 	tpl                       *template.Template
 	serviceTpl                *template.Template
+	extensionTpl              *template.Template
+	enumTpl                   *template.Template
+	serviceExtensionTpl       *template.Template
+	commonMethodsTpl          *template.Template
+	wellKnownTypesTpl         *template.Template
 	hideCommonMethods         bool
 	useAbstractMessage        bool
 	useGenericProtoContainers bool
+	useSorbetEnums            bool
+	sharedCommonMethods       bool
+	typedToH                  bool
+	wellKnownTypeMethods      bool
+	genericContainersShim     bool
+	runtimeVersion            int
 }
 
 func (m *rbiModule) HideCommonMethods() bool {
@@ -42,6 +71,30 @@ func (m *rbiModule) UseGenericProtoContainers() bool {
 	return m.useGenericProtoContainers
 }
 
+func (m *rbiModule) UseSorbetEnums() bool {
+	return m.useSorbetEnums
+}
+
+func (m *rbiModule) SharedCommonMethods() bool {
+	return m.sharedCommonMethods
+}
+
+func (m *rbiModule) TypedToH() bool {
+	return m.typedToH
+}
+
+func (m *rbiModule) WellKnownTypeMethods() bool {
+	return m.wellKnownTypeMethods
+}
+
+func (m *rbiModule) GenericContainersShim() bool {
+	return m.genericContainersShim
+}
+
+func (m *rbiModule) RuntimeVersion() int {
+	return m.runtimeVersion
+}
+
 func RBI() *rbiModule { return &rbiModule{ModuleBase: &pgs.ModuleBase{}} }
 
 func (m *rbiModule) InitContext(c pgs.BuildContext) {
@@ -66,11 +119,51 @@ func (m *rbiModule) InitContext(c pgs.BuildContext) {
 	}
 	m.useGenericProtoContainers = useGenericProtoContainers
 
+	useSorbetEnums, err := m.ctx.Params().BoolDefault("use_sorbet_enums", false)
+	if err != nil {
+		log.Panicf("Bad parameter: use_sorbet_enums\n")
+	}
+	m.useSorbetEnums = useSorbetEnums
+
+	sharedCommonMethods, err := m.ctx.Params().BoolDefault("shared_common_methods", false)
+	if err != nil {
+		log.Panicf("Bad parameter: shared_common_methods\n")
+	}
+	m.sharedCommonMethods = sharedCommonMethods
+
+	typedToH, err := m.ctx.Params().BoolDefault("typed_to_h", false)
+	if err != nil {
+		log.Panicf("Bad parameter: typed_to_h\n")
+	}
+	m.typedToH = typedToH
+
+	wellKnownTypeMethods, err := m.ctx.Params().BoolDefault("well_known_type_methods", false)
+	if err != nil {
+		log.Panicf("Bad parameter: well_known_type_methods\n")
+	}
+	m.wellKnownTypeMethods = wellKnownTypeMethods
+
+	genericContainersShim, err := m.ctx.Params().BoolDefault("generic_containers_shim", false)
+	if err != nil {
+		log.Panicf("Bad parameter: generic_containers_shim\n")
+	}
+	m.genericContainersShim = genericContainersShim
+
+	runtimeVersion, err := m.ctx.Params().IntDefault("runtime_version", MinimumRuntimeVersion)
+	if err != nil || runtimeVersion < MinimumRuntimeVersion || runtimeVersion > MaximumRuntimeVersion {
+		log.Panicf("Bad parameter: runtime_version\n")
+	}
+	m.runtimeVersion = runtimeVersion
+
 	funcs := map[string]interface{}{
 		"increment":                 m.increment,
-		"optional":                  m.optional,
+		"hasPresence":               ruby_types.HasPresence,
 		"optionalOneOf":             m.optionalOneOf,
 		"willGenerateInvalidRuby":   m.willGenerateInvalidRuby,
+		"validRubyField":            m.validRubyField,
+		"invalidRubyFields":         m.invalidRubyFields,
+		"reservedFieldName":         ruby_types.ReservedFieldName,
+		"derivedMethodClash":        ruby_types.DerivedMethodClash,
 		"rubyPackage":               ruby_types.RubyPackage,
 		"rubyMessageType":           ruby_types.RubyMessageType,
 		"rubyMessageTypeComment":    ruby_types.RubyMessageTypeComment,
@@ -78,18 +171,49 @@ func (m *rbiModule) InitContext(c pgs.BuildContext) {
 		"rubyGetterFieldType":       ruby_types.RubyGetterFieldType,
 		"rubySetterFieldType":       ruby_types.RubySetterFieldType,
 		"rubyInitializerFieldType":  ruby_types.RubyInitializerFieldType,
+		"rubyInitializerFieldsType": ruby_types.RubyInitializerFieldsType,
 		"rubyFieldValue":            ruby_types.RubyFieldValue,
+		"rubyEnumConstFieldType":    ruby_types.RubyEnumConstFieldType,
+		"rubyWrapperValueType":      ruby_types.RubyWrapperValueType,
+		"rubySorbetEnumType":        ruby_types.RubySorbetEnumType,
+		"rubySorbetEnumFieldType":   ruby_types.RubySorbetEnumFieldType,
 		"rubyMethodTypeComment":     ruby_types.RubyMethodTypeComment,
 		"rubyMethodParamType":       ruby_types.RubyMethodParamType,
 		"rubyMethodReturnType":      ruby_types.RubyMethodReturnType,
+		"rubyHandlerParams":         ruby_types.RubyHandlerParams,
 		"rubyEnumValueName":         ruby_types.RubyEnumValueName,
+		"rubyEnumValueShadowed":     ruby_types.RubyEnumValueShadowed,
+		"rubyOneOfCases":            ruby_types.RubyOneOfCases,
+		"rubyToHType":               ruby_types.RubyToHType,
+		"rubyExtensionName":         ruby_types.RubyExtensionName,
+		"rubyExtensionConstant":     ruby_types.RubyExtensionConstant,
+		"rubyExtensionLookupName":   ruby_types.RubyExtensionLookupName,
+		"rubyPackageModules":        ruby_types.RubyPackageModules,
+		"allExtensions":             m.allExtensions,
+		"rubyRequirePath":           m.rubyRequirePath,
+		"rubyServicesRequirePath":   m.rubyServicesRequirePath,
+		"stubOpVariant":             m.stubOpVariant,
+		"sorbetEnumFields":          m.sorbetEnumFields,
+		"sorbetEnumField":           m.sorbetEnumField,
+		"sorbetEnumRequires":        m.sorbetEnumRequires,
 		"hideCommonMethods":         m.HideCommonMethods,
 		"useAbstractMessage":        m.UseAbstractMessage,
 		"useGenericProtoContainers": m.UseGenericProtoContainers,
+		"useSorbetEnums":            m.UseSorbetEnums,
+		"sharedCommonMethods":       m.SharedCommonMethods,
+		"typedToH":                  m.TypedToH,
+		"runtimeVersion":            m.RuntimeVersion,
+		"decodeJSONOptions":         m.decodeJSONOptions,
+		"encodeJSONOptions":         m.encodeJSONOptions,
 	}
 
 	m.tpl = template.Must(template.New("rbi").Funcs(funcs).Parse(tpl))
 	m.serviceTpl = template.Must(template.New("rbiService").Funcs(funcs).Parse(serviceTpl))
+	m.extensionTpl = template.Must(template.New("rbExtension").Funcs(funcs).Parse(extensionTpl))
+	m.enumTpl = template.Must(template.New("rbEnum").Funcs(funcs).Parse(enumTpl))
+	m.serviceExtensionTpl = template.Must(template.New("rbServiceExtension").Funcs(funcs).Parse(serviceExtensionTpl))
+	m.commonMethodsTpl = template.Must(template.New("rbiCommonMethods").Funcs(funcs).Parse(commonMethodsTpl))
+	m.wellKnownTypesTpl = template.Must(template.New("rbiWellKnownTypes").Funcs(funcs).Parse(wellKnownTypesTpl))
 }
 
 func (m *rbiModule) Name() string { return "rbi" }
@@ -106,50 +230,276 @@ func (m *rbiModule) Execute(targets map[string]pgs.File, pkgs map[string]pgs.Pac
 		if len(t.Services()) > 0 && grpc {
 			m.generateServices(t)
 		}
+
+		if len(m.allExtensions(t)) > 0 {
+			m.generateExtensions(t)
+		}
+
+		if m.useSorbetEnums && m.hasSorbetEnums(t) {
+			m.generateEnums(t)
+		}
+	}
+
+	if m.sharedCommonMethods && !m.hideCommonMethods {
+		m.AddGeneratorTemplateFile(commonMethodsFile, m.commonMethodsTpl, nil)
+	}
+
+	if m.wellKnownTypeMethods {
+		m.AddGeneratorTemplateFile(wellKnownTypesFile, m.wellKnownTypesTpl, nil)
+	}
+
+	if m.genericContainersShim {
+		m.AddGeneratorFile(genericContainersFile, genericContainersRbi)
 	}
 	return m.Artifacts()
 }
 
 func (m *rbiModule) generate(f pgs.File) {
+	for _, msg := range f.AllMessages() {
+		for _, field := range msg.Fields() {
+			if ruby_types.ReservedFieldName(field) {
+				m.Logf("Warning: %s clashes with an existing Ruby method, its getter will not be generated\n", field.FullyQualifiedName())
+			}
+			if ruby_types.RubyEnumConstFieldType(field) != "" && ruby_types.DerivedMethodClash(field, "_const") {
+				m.Logf("Warning: %s_const clashes with another field, the _const accessor of %s will not be generated\n", field.FullyQualifiedName(), field.FullyQualifiedName())
+			}
+			if ruby_types.RubyWrapperValueType(field) != "" && ruby_types.DerivedMethodClash(field, "_as_value") {
+				m.Logf("Warning: %s_as_value clashes with another field, the _as_value accessors of %s will not be generated\n", field.FullyQualifiedName(), field.FullyQualifiedName())
+			}
+			if m.useSorbetEnums && ruby_types.RubySorbetEnumFieldType(field) != "" {
+				if ruby_types.DerivedMethodClash(field, "_as_enum") {
+					m.Logf("Warning: %s_as_enum clashes with another field, the _as_enum accessors of %s will not be generated\n", field.FullyQualifiedName(), field.FullyQualifiedName())
+				} else if enum := fieldEnum(field); !enum.BuildTarget() {
+					m.Logf("Warning: %s isn't generated along with %s, the _as_enum accessors of %s will not be generated\n", enum.FullyQualifiedName(), field.File().InputPath(), field.FullyQualifiedName())
+				}
+			}
+		}
+	}
+
 	op := strings.TrimSuffix(f.InputPath().String(), ".proto") + "_pb.rbi"
 	m.AddGeneratorTemplateFile(op, m.tpl, f)
 }
 
 func (m *rbiModule) generateServices(f pgs.File) {
+	for _, service := range f.Services() {
+		for _, method := range service.Methods() {
+			if !m.stubOpVariant(method) {
+				m.Logf("Warning: %s_op clashes with the stub method of another RPC, the return_op variant of %s will not be generated\n", method.Name().LowerSnakeCase(), method.FullyQualifiedName())
+			}
+		}
+	}
+
 	op := strings.TrimSuffix(f.InputPath().String(), ".proto") + "_services_pb.rbi"
 	m.AddGeneratorTemplateFile(op, m.serviceTpl, f)
+
+	op = strings.TrimSuffix(f.InputPath().String(), ".proto") + "_services_ext_pb.rb"
+	m.AddGeneratorTemplateFile(op, m.serviceExtensionTpl, f)
 }
 
-func (m *rbiModule) increment(i int) int {
-	return i + 1
+// generateExtensions writes the Ruby module backing the Extensions module
+// declared in the RBI, as the runtime only exposes extensions through the
+// DescriptorPool.
+func (m *rbiModule) generateExtensions(f pgs.File) {
+	names := make(map[string]pgs.Extension)
+	for _, ext := range definedExtensions(f) {
+		name := strings.ToLower(ruby_types.RubyExtensionName(ext))
+		if other, ok := names[name]; ok {
+			m.Logf("Warning: %s clashes with %s in the Extensions module, its accessors will not be generated\n", ext.FullyQualifiedName(), other.FullyQualifiedName())
+			continue
+		}
+		names[name] = ext
+	}
+
+	op := strings.TrimSuffix(f.InputPath().String(), ".proto") + "_ext_pb.rb"
+	m.AddGeneratorTemplateFile(op, m.extensionTpl, f)
+}
+
+// generateEnums writes the Ruby file defining the T::Enum companions and the
+// `<field>_as_enum` accessors declared in the RBI.
+func (m *rbiModule) generateEnums(f pgs.File) {
+	op := strings.TrimSuffix(f.InputPath().String(), ".proto") + "_enums_pb.rb"
+	m.AddGeneratorTemplateFile(op, m.enumTpl, f)
+}
+
+func (m *rbiModule) hasSorbetEnums(f pgs.File) bool {
+	if len(f.AllEnums()) > 0 {
+		return true
+	}
+	for _, msg := range f.AllMessages() {
+		if len(m.sorbetEnumFields(msg)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *rbiModule) sorbetEnumFields(msg pgs.Message) []pgs.Field {
+	fields := make([]pgs.Field, 0)
+	for _, field := range msg.Fields() {
+		if m.sorbetEnumField(field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// sorbetEnumField returns true when field gets `<field>_as_enum` accessors.
+// The T::Enum companion of an enum is only written along with its file, so
+// fields of enums declared in files that aren't generated are left out.
+func (m *rbiModule) sorbetEnumField(field pgs.Field) bool {
+	if ruby_types.RubySorbetEnumFieldType(field) == "" || ruby_types.DerivedMethodClash(field, "_as_enum") {
+		return false
+	}
+	return fieldEnum(field).BuildTarget()
+}
+
+// fieldEnum returns the enum of a singular or repeated enum field
+func fieldEnum(field pgs.Field) pgs.Enum {
+	t := field.Type()
+	if t.IsRepeated() {
+		return t.Element().Enum()
+	}
+	return t.Enum()
+}
+
+// sorbetEnumRequires returns the `_enums_pb` files defining the T::Enum
+// companions of enums that f uses but doesn't declare.
+func (m *rbiModule) sorbetEnumRequires(f pgs.File) []string {
+	requires := make([]string, 0)
+	seen := map[string]bool{f.InputPath().String(): true}
+	for _, msg := range f.AllMessages() {
+		for _, field := range m.sorbetEnumFields(msg) {
+			path := fieldEnum(field).File().InputPath().String()
+			if !seen[path] {
+				seen[path] = true
+				requires = append(requires, strings.TrimSuffix(path, ".proto")+"_enums_pb")
+			}
+		}
+	}
+	return requires
+}
+
+// stubOpVariant returns false when the `<rpc>_op` variant of method would
+// replace the stub method of another RPC of the service.
+func (m *rbiModule) stubOpVariant(method pgs.Method) bool {
+	name := method.Name().LowerSnakeCase().String() + "_op"
+	for _, other := range method.Service().Methods() {
+		if other.Name().LowerSnakeCase().String() == name {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *rbiModule) rubyRequirePath(f pgs.File) string {
+	return strings.TrimSuffix(f.InputPath().String(), ".proto") + "_pb"
+}
+
+func (m *rbiModule) rubyServicesRequirePath(f pgs.File) string {
+	return strings.TrimSuffix(f.InputPath().String(), ".proto") + "_services_pb"
+}
+
+// allExtensions returns the extensions declared in f, leaving out any that
+// would be exposed under the same name as an earlier one, as the constants of
+// the Extensions module are all upper case.
+func (m *rbiModule) allExtensions(f pgs.File) []pgs.Extension {
+	exts := make([]pgs.Extension, 0)
+	names := make(map[string]bool)
+	for _, ext := range definedExtensions(f) {
+		name := strings.ToLower(ruby_types.RubyExtensionName(ext))
+		if !names[name] {
+			names[name] = true
+			exts = append(exts, ext)
+		}
+	}
+	return exts
+}
+
+func definedExtensions(f pgs.File) []pgs.Extension {
+	exts := f.DefinedExtensions()
+	for _, msg := range f.AllMessages() {
+		exts = append(exts, msg.DefinedExtensions()...)
+	}
+	return exts
+}
+
+// jsonOption is a keyword option of the runtime's JSON methods
+type jsonOption struct {
+	Name    string
+	Type    string
+	Default string
+}
+
+// decodeJSONOptions lists the options decode_json accepts. google-protobuf 3
+// and 4 take the same JSON options, so runtime_version doesn't change them yet.
+func (m *rbiModule) decodeJSONOptions() []jsonOption {
+	return []jsonOption{
+		{Name: "ignore_unknown_fields", Type: "T::Boolean", Default: "false"},
+	}
+}
+
+// encodeJSONOptions lists the options encode_json and to_json accept.
+func (m *rbiModule) encodeJSONOptions() []jsonOption {
+	return []jsonOption{
+		{Name: "preserve_proto_fieldnames", Type: "T::Boolean", Default: "false"},
+		{Name: "emit_defaults", Type: "T::Boolean", Default: "false"},
+		{Name: "format_enums_as_integers", Type: "T::Boolean", Default: "false"},
+	}
 }
 
-func (m *rbiModule) optional(field pgs.Field) bool {
-	return field.Descriptor().GetProto3Optional()
+func (m *rbiModule) increment(i int) int {
+	return i + 1
 }
 
 func (m *rbiModule) optionalOneOf(oneOf pgs.OneOf) bool {
 	return len(oneOf.Fields()) == 1 && oneOf.Fields()[0].Descriptor().GetProto3Optional()
 }
 
-func (m *rbiModule) willGenerateInvalidRuby(fields []pgs.Field) bool {
+func (m *rbiModule) validRubyField(field pgs.Field) bool {
+	return validRubyField.MatchString(string(field.Name()))
+}
+
+func (m *rbiModule) invalidRubyFields(fields []pgs.Field) []pgs.Field {
+	invalid := make([]pgs.Field, 0)
 	for _, field := range fields {
-		if !validRubyField.MatchString(string(field.Name())) {
-			return true
+		if !m.validRubyField(field) {
+			invalid = append(invalid, field)
 		}
 	}
-	return false
+	return invalid
+}
+
+func (m *rbiModule) willGenerateInvalidRuby(fields []pgs.Field) bool {
+	return len(m.invalidRubyFields(fields)) > 0
 }
 
 func main() {
+	var out bytes.Buffer
 	pgs.Init(
 		pgs.DebugEnv("DEBUG"),
 		pgs.SupportedFeatures(&SupportedFeatures),
+		pgs.ProtocOutput(&out),
 	).RegisterModule(
 		RBI(),
 	).RegisterPostProcessor(
 		pgsgo.GoFmt(),
 	).Render()
+
+	// protoc-gen-star has no option for the supported editions, so they are added to its response here
+	resp := &pluginpb.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(out.Bytes(), resp); err != nil {
+		log.Panicf("Bad plugin response: %v\n", err)
+	}
+	resp.MinimumEdition = proto.Int32(int32(MinimumEdition))
+	resp.MaximumEdition = proto.Int32(int32(MaximumEdition))
+
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		log.Panicf("Bad plugin response: %v\n", err)
+	}
+	if _, err := os.Stdout.Write(data); err != nil {
+		log.Panicf("Unable to write plugin response: %v\n", err)
+	}
 }
 
 const tpl = `# Code generated by protoc-gen-rbi. DO NOT EDIT.
@@ -159,32 +509,36 @@ const tpl = `# Code generated by protoc-gen-rbi. DO NOT EDIT.
 # {{ rubyMessageTypeComment . }}{{ end }}
 class {{ rubyMessageType . }}{{ if useAbstractMessage }} < ::Google::Protobuf::AbstractMessage{{ else }}
   include ::Google::Protobuf::MessageExts
-  extend ::Google::Protobuf::MessageExts::ClassMethods
-{{ end }}{{ if willGenerateInvalidRuby .Fields }}
-  # Constants of the form Constant_1 are invalid. We've declined to type this as a result, taking a hash instead.
-  sig { params(args: T::Hash[T.untyped, T.untyped]).void }
-  def initialize(args); end
-{{ else if gt (len .Fields) 0 }}
+  extend ::Google::Protobuf::MessageExts::ClassMethods{{ end }}{{ if and sharedCommonMethods (not hideCommonMethods) }}
+  include ::ProtocGenRbi::MessageMethods
+  extend ::ProtocGenRbi::MessageClassMethods{{ end }}{{ if or (not useAbstractMessage) (and sharedCommonMethods (not hideCommonMethods)) }}
+{{ end }}{{ if gt (len .Fields) 0 }}{{ if willGenerateInvalidRuby .Fields }}
+  # Fields of the form Field_1 aren't valid parameter names, so they are taken through _kwargs instead.{{ end }}
   sig do
-    params({{ $index := 0 }}{{ range .Fields }}{{ if gt $index 0 }},{{ end }}{{ $index = increment $index }}
-      {{ .Name }}: {{ rubyInitializerFieldType . }}{{ end }}
+    params({{ $index := 0 }}{{ range .Fields }}{{ if validRubyField . }}{{ if gt $index 0 }},{{ end }}{{ $index = increment $index }}
+      {{ .Name }}: {{ rubyInitializerFieldType . }}{{ end }}{{ end }}{{ if willGenerateInvalidRuby .Fields }}{{ if gt $index 0 }},{{ end }}
+      _kwargs: {{ rubyInitializerFieldsType (invalidRubyFields .Fields) }}{{ end }}
     ).void
   end
-  def initialize({{ $index := 0 }}{{ range .Fields }}{{ if gt $index 0 }},{{ end }}{{ $index = increment $index }}
-    {{ .Name }}: {{ rubyFieldValue . }}{{ end }}
+  def initialize({{ $index := 0 }}{{ range .Fields }}{{ if validRubyField . }}{{ if gt $index 0 }},{{ end }}{{ $index = increment $index }}
+    {{ .Name }}: {{ rubyFieldValue . }}{{ end }}{{ end }}{{ if willGenerateInvalidRuby .Fields }}{{ if gt $index 0 }},{{ end }}
+    **_kwargs{{ end }}
   )
   end
 {{ else }}
   sig {void}
   def initialize; end
 {{ end }}{{ range .Fields }}{{ if rubyFieldTypeComment . }}
-  # {{ rubyFieldTypeComment . }}{{ end }}
+  # {{ rubyFieldTypeComment . }}{{ end }}{{ if reservedFieldName . }}
+  # The {{ .Name }} getter can't be declared, as it would change the signature of an existing method.
+  # Read the field with self["{{ .Name }}"] instead.
+{{ else }}
   sig { returns({{ rubyGetterFieldType . useGenericProtoContainers }}) }
   def {{ .Name }}
   end
-{{ if rubyFieldTypeComment . }}
+{{ end }}{{ if rubyFieldTypeComment . }}
   # {{ rubyFieldTypeComment . }}{{ end }}
-  sig { params(value: {{ rubySetterFieldType . useGenericProtoContainers }}).void }
+  sig { params(value: {{ rubySetterFieldType . useGenericProtoContainers runtimeVersion }}).void }
   def {{ .Name }}=(value)
   end
 {{ if rubyFieldTypeComment . }}
@@ -192,15 +546,50 @@ class {{ rubyMessageType . }}{{ if useAbstractMessage }} < ::Google::Protobuf::A
   sig { void }
   def clear_{{ .Name }}
   end
-{{ if optional . }}
+{{ if hasPresence . }}
   sig { returns(T::Boolean) }
   def has_{{ .Name }}?
   end
-{{ end }}{{ end }}{{ range .OneOfs }}{{ if not (optionalOneOf .) }}
+{{ end }}{{ if and (rubyEnumConstFieldType .) (not (derivedMethodClash . "_const")) }}
+  sig { returns({{ rubyEnumConstFieldType . }}) }
+  def {{ .Name }}_const
+  end
+{{ end }}{{ if and (rubyWrapperValueType .) (not (derivedMethodClash . "_as_value")) }}
+  sig { returns(T.nilable({{ rubyWrapperValueType . }})) }
+  def {{ .Name }}_as_value
+  end
+
+  sig { params(value: T.nilable({{ rubyWrapperValueType . }})).void }
+  def {{ .Name }}_as_value=(value)
+  end
+{{ end }}{{ if and useSorbetEnums (sorbetEnumField .) }}
+  sig { returns({{ rubySorbetEnumFieldType . }}) }
+  def {{ .Name }}_as_enum
+  end
+{{ if not .Type.IsRepeated }}
+  sig { params(value: {{ rubySorbetEnumType .Type.Enum }}).void }
+  def {{ .Name }}_as_enum=(value)
+  end
+{{ end }}{{ end }}{{ end }}{{ range .OneOfs }}{{ if not (optionalOneOf .) }}
+  # One of {{ rubyOneOfCases . }}
   sig { returns(T.nilable(Symbol)) }
   def {{ .Name }}
   end
-{{ end }}{{ end }}{{ if hideCommonMethods }}{{ else }}
+
+  sig { returns(T::Boolean) }
+  def has_{{ .Name }}?
+  end
+
+  sig { void }
+  def clear_{{ .Name }}
+  end
+{{ end }}{{ end }}{{ if and typedToH (not hideCommonMethods) }}
+  ToHShape = T.type_alias { {{ rubyToHType . runtimeVersion }} }
+{{ end }}{{ if hideCommonMethods }}{{ else if sharedCommonMethods }}{{ if typedToH }}
+  sig { returns(ToHShape) }
+  def to_h
+  end
+{{ end }}{{ else }}
   sig { params(field: String).returns(T.untyped) }
   def [](field)
   end
@@ -209,10 +598,22 @@ class {{ rubyMessageType . }}{{ if useAbstractMessage }} < ::Google::Protobuf::A
   def []=(field, value)
   end
 
-  sig { returns(T::Hash[Symbol, T.untyped]) }
+  sig { returns({{ if typedToH }}ToHShape{{ else }}T::Hash[Symbol, T.untyped]{{ end }}) }
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params({{ range $i, $opt := encodeJSONOptions }}{{ if $i }},{{ end }}
+      {{ .Name }}: {{ .Type }}{{ end }}
+    ).returns(String)
+  end
+  def to_json({{ range $i, $opt := encodeJSONOptions }}{{ if $i }}, {{ end }}{{ .Name }}: {{ .Default }}{{ end }})
+  end
+
   sig { params(str: String).returns({{ rubyMessageType . }}) }
   def self.decode(str)
   end
@@ -221,12 +622,22 @@ class {{ rubyMessageType . }}{{ if useAbstractMessage }} < ::Google::Protobuf::A
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns({{ rubyMessageType . }}) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String{{ range decodeJSONOptions }},
+      {{ .Name }}: {{ .Type }}{{ end }}
+    ).returns({{ rubyMessageType . }})
+  end
+  def self.decode_json(str{{ range decodeJSONOptions }}, {{ .Name }}: {{ .Default }}{{ end }})
   end
 
-  sig { params(msg: {{ rubyMessageType . }}, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: {{ rubyMessageType . }}{{ range encodeJSONOptions }},
+      {{ .Name }}: {{ .Type }}{{ end }}
+    ).returns(String)
+  end
+  def self.encode_json(msg{{ range encodeJSONOptions }}, {{ .Name }}: {{ .Default }}{{ end }})
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -234,8 +645,10 @@ class {{ rubyMessageType . }}{{ if useAbstractMessage }} < ::Google::Protobuf::A
   end
 {{ end }}end
 {{ end }}{{ range .AllEnums }}
-module {{ rubyMessageType . }}{{ range .Values }}
-  self::{{ rubyEnumValueName .Name }} = T.let({{ .Value }}, Integer){{ end }}
+module {{ rubyMessageType . }}{{ range .Values }}{{ if not (rubyEnumValueName .Name) }}
+  # {{ .Name }} doesn't start with a letter, so it has no constant. Use lookup({{ .Value }}) and resolve(:{{ .Name }}) instead.{{ else if rubyEnumValueShadowed . }}
+  # {{ .Name }} is overwritten by a later value with the same constant name. Use lookup({{ .Value }}) and resolve(:{{ .Name }}) instead.{{ else }}
+  self::{{ rubyEnumValueName .Name }} = T.let({{ .Value }}, Integer){{ end }}{{ end }}
 
   sig { params(value: Integer).returns(T.nilable(Symbol)) }
   def self.lookup(value)
@@ -249,8 +662,410 @@ module {{ rubyMessageType . }}{{ range .Values }}
   def self.descriptor
   end
 end
+{{ if useSorbetEnums }}
+class {{ rubySorbetEnumType . }} < T::Enum
+  enums do{{ range .Values }}{{ if and (rubyEnumValueName .Name) (not (rubyEnumValueShadowed .)) }}
+    {{ rubyEnumValueName .Name }} = new(:{{ .Name }}){{ end }}{{ end }}
+  end
+
+  sig { params(value: T.any(Symbol, Integer)).returns(T.nilable({{ rubySorbetEnumType . }})) }
+  def self.from_proto(value)
+  end
+
+  sig { returns(Symbol) }
+  def to_proto
+  end
+
+  sig { returns(Integer) }
+  def to_i
+  end
+end
+{{ end }}{{ end }}{{ with allExtensions . }}
+module {{ rubyPackage $.File }}::Extensions{{ range . }}
+  {{ rubyExtensionConstant . }} = T.let(T.unsafe(nil), ::Google::Protobuf::FieldDescriptor){{ end }}
+{{ range . }}{{ if rubyFieldTypeComment . }}
+  # {{ rubyFieldTypeComment . }}{{ end }}
+  sig { params(msg: {{ rubyMessageType .Extendee }}).returns({{ rubyGetterFieldType . useGenericProtoContainers }}) }
+  def self.{{ rubyExtensionName . }}(msg)
+  end
+{{ if rubyFieldTypeComment . }}
+  # {{ rubyFieldTypeComment . }}{{ end }}
+  sig { params(msg: {{ rubyMessageType .Extendee }}, value: {{ rubySetterFieldType . useGenericProtoContainers runtimeVersion }}).void }
+  def self.set_{{ rubyExtensionName . }}(msg, value)
+  end
+{{ if rubyFieldTypeComment . }}
+  # {{ rubyFieldTypeComment . }}{{ end }}
+  sig { params(msg: {{ rubyMessageType .Extendee }}).void }
+  def self.clear_{{ rubyExtensionName . }}(msg)
+  end
+{{ if not .Type.IsRepeated }}
+  sig { params(msg: {{ rubyMessageType .Extendee }}).returns(T::Boolean) }
+  def self.has_{{ rubyExtensionName . }}?(msg)
+  end
+{{ end }}{{ end }}end
 {{ end }}`
 
+// commonMethodsFile declares the methods every message shares once, for the
+// shared_common_methods option, instead of repeating them in each class.
+const commonMethodsFile = "protoc_gen_rbi_common.rbi"
+
+const commonMethodsTpl = `# Code generated by protoc-gen-rbi. DO NOT EDIT.
+# typed: strict
+
+module ProtocGenRbi
+  module MessageMethods
+    sig { params(field: String).returns(T.untyped) }
+    def [](field)
+    end
+
+    sig { params(field: String, value: T.untyped).void }
+    def []=(field, value)
+    end
+
+    sig { returns(T::Hash[Symbol, T.untyped]) }
+    def to_h
+    end
+
+    sig { returns(String) }
+    def to_proto
+    end
+
+    sig do
+      params({{ range $i, $opt := encodeJSONOptions }}{{ if $i }},{{ end }}
+        {{ .Name }}: {{ .Type }}{{ end }}
+      ).returns(String)
+    end
+    def to_json({{ range $i, $opt := encodeJSONOptions }}{{ if $i }}, {{ end }}{{ .Name }}: {{ .Default }}{{ end }})
+    end
+  end
+
+  module MessageClassMethods
+    extend T::Generic
+
+    has_attached_class!
+
+    sig { params(str: String).returns(T.attached_class) }
+    def decode(str)
+    end
+
+    sig { params(msg: T.attached_class).returns(String) }
+    def encode(msg)
+    end
+
+    sig do
+      params(
+        str: String{{ range decodeJSONOptions }},
+        {{ .Name }}: {{ .Type }}{{ end }}
+      ).returns(T.attached_class)
+    end
+    def decode_json(str{{ range decodeJSONOptions }}, {{ .Name }}: {{ .Default }}{{ end }})
+    end
+
+    sig do
+      params(
+        msg: T.attached_class{{ range encodeJSONOptions }},
+        {{ .Name }}: {{ .Type }}{{ end }}
+      ).returns(String)
+    end
+    def encode_json(msg{{ range encodeJSONOptions }}, {{ .Name }}: {{ .Default }}{{ end }})
+    end
+
+    sig { returns(::Google::Protobuf::Descriptor) }
+    def descriptor
+    end
+  end
+end
+`
+
+// wellKnownTypesFile declares the methods google/protobuf/well_known_types
+// adds to the well-known types, for the well_known_type_methods option.
+const wellKnownTypesFile = "protoc_gen_rbi_well_known_types.rbi"
+
+const wellKnownTypesTpl = `# Code generated by protoc-gen-rbi. DO NOT EDIT.
+# typed: strict
+
+class Google::Protobuf::Any
+  sig do
+    params(
+      msg: {{ if useAbstractMessage }}::Google::Protobuf::AbstractMessage{{ else }}::Google::Protobuf::MessageExts{{ end }},
+      type_url_prefix: String
+    ).returns(Google::Protobuf::Any)
+  end
+  def self.pack(msg, type_url_prefix = "type.googleapis.com/")
+  end
+
+  sig do
+    params(
+      msg: {{ if useAbstractMessage }}::Google::Protobuf::AbstractMessage{{ else }}::Google::Protobuf::MessageExts{{ end }},
+      type_url_prefix: String
+    ).returns(String)
+  end
+  def pack(msg, type_url_prefix = "type.googleapis.com/")
+  end
+
+  sig do
+    type_parameters(:M)
+      .params(klass: T::Class[T.type_parameter(:M)])
+      .returns(T.nilable(T.type_parameter(:M)))
+  end
+  def unpack(klass)
+  end
+
+  sig { returns(String) }
+  def type_name
+  end
+
+  sig { params(klass: T::Class[T.anything]).returns(T::Boolean) }
+  def is(klass)
+  end
+end
+
+class Google::Protobuf::Timestamp
+  sig { params(time: Time).returns(Google::Protobuf::Timestamp) }
+  def self.from_time(time)
+  end
+
+  sig { params(time: Time).returns(T.self_type) }
+  def from_time(time)
+  end
+
+  sig { returns(Time) }
+  def to_time
+  end
+
+  sig { returns(Integer) }
+  def to_i
+  end
+end
+
+class Google::Protobuf::Duration
+  sig { returns(Float) }
+  def to_f
+  end
+end
+
+class Google::Protobuf::Struct
+  sig { params(key: String).returns(T.untyped) }
+  def [](key)
+  end
+
+  sig { params(key: String, value: T.untyped).void }
+  def []=(key, value)
+  end
+
+  sig { returns(T::Hash[String, T.untyped]) }
+  def to_h
+  end
+
+  sig { params(key: String).returns(T::Boolean) }
+  def has_key?(key)
+  end
+
+  sig { params(hash: T::Hash[String, T.untyped]).returns(Google::Protobuf::Struct) }
+  def self.from_hash(hash)
+  end
+end
+
+class Google::Protobuf::Value
+  sig { params(recursive: T::Boolean).returns(T.untyped) }
+  def to_ruby(recursive = false)
+  end
+
+  sig { params(value: T.untyped).returns(Google::Protobuf::Value) }
+  def self.from_ruby(value)
+  end
+
+  sig { params(value: T.untyped).returns(T.self_type) }
+  def from_ruby(value)
+  end
+end
+
+class Google::Protobuf::ListValue
+  extend T::Generic
+  include Enumerable
+
+  Elem = type_member { { fixed: T.untyped } }
+
+  sig { returns(Integer) }
+  def length
+  end
+
+  sig { params(index: Integer).returns(T.untyped) }
+  def [](index)
+  end
+
+  sig { params(index: Integer, value: T.untyped).void }
+  def []=(index, value)
+  end
+
+  # Returns the values field, as the value is appended to it.
+  sig { params(value: T.untyped).returns({{ template "listValues" }}) }
+  def <<(value)
+  end
+
+  sig { override.params(blk: T.proc.params(value: T.untyped).returns(BasicObject)).returns({{ template "listValues" }}) }
+  def each(&blk)
+  end
+
+  sig { returns(T::Array[T.untyped]) }
+  def to_a
+  end
+
+  sig { params(arr: T::Array[T.untyped]).returns(Google::Protobuf::ListValue) }
+  def self.from_a(arr)
+  end
+end
+{{ define "listValues" }}{{ if useGenericProtoContainers }}::Google::Protobuf::RepeatedField[::Google::Protobuf::Value]{{ else }}::Google::Protobuf::RepeatedField{{ end }}{{ end }}`
+
+// genericContainersFile declares RepeatedField and Map as generic classes,
+// which use_generic_proto_containers relies on, for the
+// generic_containers_shim option.
+const genericContainersFile = "protoc_gen_rbi_containers.rbi"
+
+const genericContainersRbi = `# Code generated by protoc-gen-rbi. DO NOT EDIT.
+# typed: strict
+
+class Google::Protobuf::RepeatedField
+  extend T::Generic
+  include Enumerable
+
+  Elem = type_member
+
+  sig { override.params(blk: T.proc.params(value: Elem).returns(BasicObject)).returns(T.self_type) }
+  def each(&blk)
+  end
+
+  sig { params(index: Integer).returns(T.nilable(Elem)) }
+  def [](index)
+  end
+
+  sig { params(index: Integer).returns(T.nilable(Elem)) }
+  def at(index)
+  end
+
+  sig { params(index: Integer, value: Elem).void }
+  def []=(index, value)
+  end
+
+  sig { params(value: Elem).returns(T.self_type) }
+  def <<(value)
+  end
+
+  sig { params(values: Elem).returns(T.self_type) }
+  def push(*values)
+  end
+
+  sig { returns(T.nilable(Elem)) }
+  def pop
+  end
+
+  sig { params(values: T::Array[Elem]).returns(T.self_type) }
+  def replace(values)
+  end
+
+  sig { params(values: T::Array[Elem]).returns(T.self_type) }
+  def concat(values)
+  end
+
+  sig { returns(T.nilable(Elem)) }
+  def first
+  end
+
+  sig { returns(T.nilable(Elem)) }
+  def last
+  end
+
+  sig do
+    type_parameters(:U)
+      .params(blk: T.proc.params(value: Elem).returns(T.type_parameter(:U)))
+      .returns(T::Array[T.type_parameter(:U)])
+  end
+  def map(&blk)
+  end
+
+  sig { returns(T.self_type) }
+  def clear
+  end
+
+  sig { returns(Integer) }
+  def length
+  end
+
+  sig { returns(Integer) }
+  def size
+  end
+
+  sig { returns(T::Boolean) }
+  def empty?
+  end
+
+  sig { returns(T::Array[Elem]) }
+  def to_ary
+  end
+
+  sig { returns(T::Array[Elem]) }
+  def to_a
+  end
+end
+
+class Google::Protobuf::Map
+  extend T::Generic
+  include Enumerable
+
+  K = type_member
+  V = type_member
+  Elem = type_member { { fixed: [K, V] } }
+
+  sig { override.params(blk: T.proc.params(key: K, value: V).returns(BasicObject)).returns(T.self_type) }
+  def each(&blk)
+  end
+
+  sig { params(key: K).returns(T.nilable(V)) }
+  def [](key)
+  end
+
+  sig { params(key: K, value: V).void }
+  def []=(key, value)
+  end
+
+  sig { params(key: K).returns(T::Boolean) }
+  def has_key?(key)
+  end
+
+  sig { params(key: K).returns(T.nilable(V)) }
+  def delete(key)
+  end
+
+  sig { returns(T::Array[K]) }
+  def keys
+  end
+
+  sig { returns(T::Array[V]) }
+  def values
+  end
+
+  sig { returns(T.self_type) }
+  def clear
+  end
+
+  sig { returns(Integer) }
+  def length
+  end
+
+  sig { returns(Integer) }
+  def size
+  end
+
+  sig { params(other: T.any(T::Hash[K, V], Google::Protobuf::Map[K, V])).returns(Google::Protobuf::Map[K, V]) }
+  def merge(other)
+  end
+
+  sig { returns(T::Hash[K, V]) }
+  def to_h
+  end
+end
+`
+
 const serviceTpl = `# Code generated by protoc-gen-rbi. DO NOT EDIT.
 # source: {{ .InputPath }}
 # typed: strict
@@ -258,27 +1073,197 @@ const serviceTpl = `# Code generated by protoc-gen-rbi. DO NOT EDIT.
 module {{ rubyPackage .File }}::{{ .Name }}
   class Service
     include ::GRPC::GenericService
+    extend T::Helpers
+
+    abstract!
+
+    sig { returns(String) }
+    def self.service_name
+    end
+
+    sig { returns(Symbol) }
+    def self.marshal_class_method
+    end
+
+    sig { returns(Symbol) }
+    def self.unmarshal_class_method
+    end
+
+    sig { returns(T::Hash[Symbol, ::GRPC::RpcDesc]) }
+    def self.rpc_descs
+    end
+
+    # Builds a new stub class on every call, the Stub constant holds one of them.
+    sig { returns(T.class_of(::GRPC::ClientStub)) }
+    def self.rpc_stub_class
+    end
+{{ template "handlers" . }}  end
+
+  # The descriptors of the service's RPCs, as found in Service.rpc_descs
+  module RpcDescs{{ range .Methods }}
+    {{ .Name.ScreamingSnakeCase }} = T.let(T.unsafe(nil), ::GRPC::RpcDesc){{ end }}
   end
 
+  module Interface
+    extend T::Helpers
+
+    interface!
+{{ template "handlers" . }}  end
+
   class Stub < ::GRPC::ClientStub
     sig do
       params(
         host: String,
         creds: T.any(::GRPC::Core::ChannelCredentials, Symbol),
-        kw: T.untyped,
+        channel_override: T.nilable(::GRPC::Core::Channel),
+        timeout: T.nilable(Numeric),
+        propagate_mask: T.nilable(Integer),
+        channel_args: T::Hash[String, T.any(String, Integer)],
+        interceptors: T::Array[::GRPC::ClientInterceptor]
       ).void
     end
-    def initialize(host, creds, **kw)
+    def initialize(host, creds, channel_override: nil, timeout: nil, propagate_mask: nil, channel_args: {}, interceptors: [])
     end{{ range .Methods }}
 {{ if rubyMethodTypeComment . }}
-    # {{ rubyMethodTypeComment . }}{{ end }}
+    # {{ rubyMethodTypeComment . }}{{ end }}{{ if .ServerStreaming }}
     sig do
       params(
-        request: {{ rubyMethodParamType . }}
+        request: {{ rubyMethodParamType . }},{{ template "callOptions" }}
+      ).returns(T::Enumerator[{{ rubyMessageType .Output }}])
+    end
+    sig do
+      params(
+        request: {{ rubyMethodParamType . }},{{ template "callOptions" }},
+        blk: T.proc.params(response: {{ rubyMessageType .Output }}).void
+      ).void
+    end
+    def {{ .Name.LowerSnakeCase }}(request, metadata: {}, deadline: nil, parent: nil, credentials: nil, &blk)
+    end{{ else }}
+    sig do
+      params(
+        request: {{ rubyMethodParamType . }},{{ template "callOptions" }}
       ).returns({{ rubyMethodReturnType . }})
     end
-    def {{ .Name.LowerSnakeCase }}(request)
-    end{{ end }}
+    def {{ .Name.LowerSnakeCase }}(request, metadata: {}, deadline: nil, parent: nil, credentials: nil)
+    end{{ end }}{{ if stubOpVariant . }}
+{{ if rubyMethodTypeComment . }}
+    # {{ rubyMethodTypeComment . }}{{ end }}
+    # Starts the call like {{ .Name.LowerSnakeCase }}(request, return_op: true), without waiting for it.
+    sig do
+      params(
+        request: {{ rubyMethodParamType . }},{{ template "callOptions" }}
+      ).returns(::GRPC::ActiveCall::Operation)
+    end
+    def {{ .Name.LowerSnakeCase }}_op(request, metadata: {}, deadline: nil, parent: nil, credentials: nil)
+    end{{ end }}{{ end }}
   end
 end
+{{ end }}{{ define "callOptions" }}
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials){{ end }}{{ define "handlers" }}{{ range .Methods }}{{ if rubyMethodTypeComment . }}
+    # {{ rubyMethodTypeComment . }}{{ end }}{{ if and .ClientStreaming (not .ServerStreaming) }}
+    # The {{ rubyMessageType .Input }} requests are read with call.each_remote_read.{{ end }}
+    sig do
+      abstract.params({{ range $i, $p := rubyHandlerParams . }}{{ if $i }},{{ end }}
+        {{ $p.Name }}: {{ $p.Type }}{{ end }}
+      ).returns({{ rubyMethodReturnType . }})
+    end
+    def {{ .Name.LowerSnakeCase }}({{ range $i, $p := rubyHandlerParams . }}{{ if $i }}, {{ end }}{{ $p.Name }}{{ end }})
+    end
+{{ end }}{{ end }}`
+
+// serviceExtensionTpl defines what the services RBI declares on top of
+// grpc-ruby: the Interface and RpcDescs modules and the `<rpc>_op` methods of
+// the stubs.
+// The abstract methods of the Interface modules are only declared in the RBI,
+// as defining them here would replace the UNIMPLEMENTED status grpc-ruby
+// returns for handlers that are missing.
+const serviceExtensionTpl = `# Code generated by protoc-gen-rbi. DO NOT EDIT.
+# source: {{ .InputPath }}
+# typed: ignore
+
+require '{{ rubyServicesRequirePath . }}'
+{{ range .Services }}
+module {{ rubyPackage .File }}::{{ .Name }}::Interface
+end
+
+module {{ rubyPackage .File }}::{{ .Name }}::RpcDescs{{ range .Methods }}
+  {{ .Name.ScreamingSnakeCase }} = {{ rubyPackage .Service.File }}::{{ .Service.Name }}::Service.rpc_descs.fetch(:{{ .Name }}){{ end }}
+end
+
+class {{ rubyPackage .File }}::{{ .Name }}::Stub{{ range .Methods }}{{ if stubOpVariant . }}
+  def {{ .Name.LowerSnakeCase }}_op(request, **kw)
+    {{ .Name.LowerSnakeCase }}(request, **kw, return_op: true)
+  end
+{{ end }}{{ end }}end
 {{ end }}`
+
+const extensionTpl = `# Code generated by protoc-gen-rbi. DO NOT EDIT.
+# source: {{ .InputPath }}
+# typed: ignore
+
+require '{{ rubyRequirePath . }}'
+{{ range rubyPackageModules . }}
+module {{ . }}; end{{ end }}
+
+module {{ rubyPackage .File }}::Extensions{{ range allExtensions . }}
+  {{ rubyExtensionConstant . }} = ::Google::Protobuf::DescriptorPool.generated_pool.lookup("{{ rubyExtensionLookupName . }}"){{ end }}
+{{ range allExtensions . }}
+  def self.{{ rubyExtensionName . }}(msg)
+    {{ rubyExtensionConstant . }}.get(msg)
+  end
+
+  def self.set_{{ rubyExtensionName . }}(msg, value)
+    {{ rubyExtensionConstant . }}.set(msg, value)
+  end
+
+  def self.clear_{{ rubyExtensionName . }}(msg)
+    {{ rubyExtensionConstant . }}.clear(msg)
+  end
+{{ if not .Type.IsRepeated }}
+  def self.has_{{ rubyExtensionName . }}?(msg)
+    {{ rubyExtensionConstant . }}.has?(msg)
+  end
+{{ end }}{{ end }}end
+`
+
+const enumTpl = `# Code generated by protoc-gen-rbi. DO NOT EDIT.
+# source: {{ .InputPath }}
+# typed: ignore
+
+require 'sorbet-runtime'
+require '{{ rubyRequirePath . }}'{{ range sorbetEnumRequires . }}
+require '{{ . }}'{{ end }}
+{{ range .AllEnums }}
+class {{ rubySorbetEnumType . }} < T::Enum
+  enums do{{ range .Values }}{{ if and (rubyEnumValueName .Name) (not (rubyEnumValueShadowed .)) }}
+    {{ rubyEnumValueName .Name }} = new(:{{ .Name }}){{ end }}{{ end }}
+  end
+
+  def self.from_proto(value)
+    value = {{ rubyMessageType . }}.lookup(value) if value.is_a?(Integer)
+    value && try_deserialize(value)
+  end
+
+  def to_proto
+    serialize
+  end
+
+  def to_i
+    {{ rubyMessageType . }}.resolve(serialize)
+  end
+end
+{{ end }}{{ range .AllMessages }}{{ if sorbetEnumFields . }}
+class {{ rubyMessageType . }}{{ range sorbetEnumFields . }}
+  def {{ .Name }}_as_enum{{ if .Type.IsRepeated }}
+    self["{{ .Name }}"].map { |value| {{ rubySorbetEnumType .Type.Element.Enum }}.from_proto(value) }{{ else }}
+    {{ rubySorbetEnumType .Type.Enum }}.from_proto(self["{{ .Name }}"]){{ end }}
+  end
+{{ if not .Type.IsRepeated }}
+  def {{ .Name }}_as_enum=(value)
+    self["{{ .Name }}"] = value.serialize
+  end
+{{ end }}{{ end }}end
+{{ end }}{{ end }}`
@@ -0,0 +1,122 @@
+package ruby_types
+
+import (
+	pgs "github.com/lyft/protoc-gen-star/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Resolution of the protobuf editions features that change the Ruby API.
+// proto2 and proto3 files are treated as the editions they are equivalent to.
+// See: https://protobuf.dev/editions/features/
+
+func fileEdition(file pgs.File) descriptorpb.Edition {
+	switch file.Descriptor().GetSyntax() {
+	case "editions":
+		return file.Descriptor().GetEdition()
+	case "proto3":
+		return descriptorpb.Edition_EDITION_PROTO3
+	}
+	return descriptorpb.Edition_EDITION_PROTO2
+}
+
+func editionDefaults(edition descriptorpb.Edition) *descriptorpb.FeatureSet {
+	switch edition {
+	case descriptorpb.Edition_EDITION_PROTO2:
+		return &descriptorpb.FeatureSet{
+			FieldPresence: descriptorpb.FeatureSet_EXPLICIT.Enum(),
+			EnumType:      descriptorpb.FeatureSet_CLOSED.Enum(),
+		}
+	case descriptorpb.Edition_EDITION_PROTO3:
+		return &descriptorpb.FeatureSet{
+			FieldPresence: descriptorpb.FeatureSet_IMPLICIT.Enum(),
+			EnumType:      descriptorpb.FeatureSet_OPEN.Enum(),
+		}
+	}
+	return &descriptorpb.FeatureSet{
+		FieldPresence: descriptorpb.FeatureSet_EXPLICIT.Enum(),
+		EnumType:      descriptorpb.FeatureSet_OPEN.Enum(),
+	}
+}
+
+// resolvedFeatures merges the features set on the entity and each of its
+// ancestors over the defaults of the file's edition.
+func resolvedFeatures(entity pgs.Entity) *descriptorpb.FeatureSet {
+	var overrides []*descriptorpb.FeatureSet
+	for e := entity; e != nil; e = featureParent(e) {
+		if features := entityFeatures(e); features != nil {
+			overrides = append(overrides, features)
+		}
+	}
+
+	resolved := editionDefaults(fileEdition(entity.File()))
+	for i := len(overrides) - 1; i >= 0; i-- {
+		proto.Merge(resolved, overrides[i])
+	}
+	return resolved
+}
+
+func featureParent(entity pgs.Entity) pgs.Entity {
+	switch e := entity.(type) {
+	case pgs.Extension:
+		return e.DefinedIn()
+	case pgs.Field:
+		if e.InRealOneOf() {
+			return e.OneOf()
+		}
+		return e.Message()
+	case pgs.OneOf:
+		return e.Message()
+	case pgs.Message:
+		return e.Parent()
+	case pgs.Enum:
+		return e.Parent()
+	}
+	return nil
+}
+
+func entityFeatures(entity pgs.Entity) *descriptorpb.FeatureSet {
+	switch e := entity.(type) {
+	case pgs.File:
+		return e.Descriptor().GetOptions().GetFeatures()
+	case pgs.Message:
+		return e.Descriptor().GetOptions().GetFeatures()
+	case pgs.Field:
+		return e.Descriptor().GetOptions().GetFeatures()
+	case pgs.OneOf:
+		return e.Descriptor().GetOptions().GetFeatures()
+	case pgs.Enum:
+		return e.Descriptor().GetOptions().GetFeatures()
+	}
+	return nil
+}
+
+// HasPresence returns true for singular fields whose presence is tracked by
+// the runtime, which then defines a `has_<field>?` predicate for them.
+func HasPresence(field pgs.Field) bool {
+	t := field.Type()
+	if t.IsRepeated() || t.IsMap() {
+		return false
+	}
+	// message fields and members of a oneof always track presence, whatever
+	// the syntax or features of the file
+	if t.IsEmbed() || field.InRealOneOf() || field.Descriptor().GetProto3Optional() {
+		return true
+	}
+	return resolvedFeatures(field).GetFieldPresence() != descriptorpb.FeatureSet_IMPLICIT
+}
+
+// Required returns true for proto2 `required` fields and their editions
+// equivalent, `features.field_presence = LEGACY_REQUIRED`.
+func Required(field pgs.Field) bool {
+	if field.Descriptor().GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REQUIRED {
+		return true
+	}
+	return resolvedFeatures(field).GetFieldPresence() == descriptorpb.FeatureSet_LEGACY_REQUIRED
+}
+
+// ClosedEnum returns true when unknown values of the enum are kept out of its
+// fields, so the runtime only ever reads them back as one of its values.
+func ClosedEnum(enum pgs.Enum) bool {
+	return resolvedFeatures(enum).GetEnumType() == descriptorpb.FeatureSet_CLOSED
+}
@@ -0,0 +1,131 @@
+package ruby_types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Helpers for turning FieldDescriptorProto.default_value into Ruby literals.
+// See: https://github.com/protocolbuffers/protobuf/blob/v22.0/src/google/protobuf/descriptor.proto#L178-L183
+
+func rubyFloatLiteral(value string) string {
+	switch value {
+	case "inf":
+		return "Float::INFINITY"
+	case "-inf":
+		return "-Float::INFINITY"
+	case "nan":
+		return "Float::NAN"
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return value
+	}
+	literal := strconv.FormatFloat(f, 'g', -1, 64)
+	// Ruby only treats a numeric literal as a Float when it has a fraction or an exponent
+	if !strings.ContainsAny(literal, ".e") {
+		literal += ".0"
+	}
+	return literal
+}
+
+// rubyStringLiteral quotes s as a double-quoted Ruby string. When binary is
+// set every non-ASCII byte is escaped, which is how bytes fields are written.
+func rubyStringLiteral(s string, binary bool) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch c {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case '#':
+			// only `#{`, `#$` and `#@` start an interpolation
+			if i+1 < len(s) && strings.IndexByte("{$@", s[i+1]) >= 0 {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(c)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if c < 0x20 || c == 0x7f {
+				fmt.Fprintf(&b, `\x%02X`, c)
+			} else if c >= utf8.RuneSelf {
+				r, size := utf8.DecodeRuneInString(s[i:])
+				if binary || r == utf8.RuneError {
+					fmt.Fprintf(&b, `\x%02X`, c)
+				} else {
+					b.WriteString(s[i : i+size])
+					i += size
+					continue
+				}
+			} else {
+				b.WriteByte(c)
+			}
+		}
+		i++
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// unescapeCBytes reverses the C-style escaping protoc applies to the default
+// value of bytes fields.
+func unescapeCBytes(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch c := s[i]; c {
+		case 'a':
+			b.WriteByte('\a')
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'v':
+			b.WriteByte('\v')
+		case 'x':
+			end := i + 1
+			for end < len(s) && end < i+3 && isHexDigit(s[end]) {
+				end++
+			}
+			v, _ := strconv.ParseUint(s[i+1:end], 16, 8)
+			b.WriteByte(byte(v))
+			i = end - 1
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			end := i
+			for end < len(s) && end < i+3 && s[end] >= '0' && s[end] <= '7' {
+				end++
+			}
+			v, _ := strconv.ParseUint(s[i:end], 8, 8)
+			b.WriteByte(byte(v))
+			i = end - 1
+		default:
+			// \\, \', \" and \? all stand for the escaped character itself
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
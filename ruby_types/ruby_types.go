@@ -16,6 +16,66 @@ const (
 	methodTypeInitializer
 )
 
+// wrapper well-known types, which the runtime unwraps through the
+// `<field>_as_value` accessors
+var wrapperValueTypes = map[string]string{
+	".google.protobuf.DoubleValue": "Float",
+	".google.protobuf.FloatValue":  "Float",
+	".google.protobuf.Int64Value":  "Integer",
+	".google.protobuf.UInt64Value": "Integer",
+	".google.protobuf.Int32Value":  "Integer",
+	".google.protobuf.UInt32Value": "Integer",
+	".google.protobuf.BoolValue":   "T::Boolean",
+	".google.protobuf.StringValue": "String",
+	".google.protobuf.BytesValue":  "String",
+}
+
+// methods every message already responds to. The runtime only exposes field
+// getters through method_missing, so a getter with one of these names can't
+// be called, except for the private initialize, and declaring it would change
+// the signature of the real method.
+var reservedMethodNames = map[string]bool{
+	// Object
+	"class":                   true,
+	"clone":                   true,
+	"define_singleton_method": true,
+	"display":                 true,
+	"dup":                     true,
+	"enum_for":                true,
+	"extend":                  true,
+	"freeze":                  true,
+	"hash":                    true,
+	"inspect":                 true,
+	"instance_eval":           true,
+	"instance_exec":           true,
+	"instance_variable_get":   true,
+	"instance_variable_set":   true,
+	"instance_variables":      true,
+	"itself":                  true,
+	"method":                  true,
+	"methods":                 true,
+	"object_id":               true,
+	"private_methods":         true,
+	"protected_methods":       true,
+	"public_method":           true,
+	"public_methods":          true,
+	"public_send":             true,
+	"send":                    true,
+	"singleton_class":         true,
+	"singleton_method":        true,
+	"singleton_methods":       true,
+	"tap":                     true,
+	"then":                    true,
+	"to_enum":                 true,
+	"to_s":                    true,
+	"yield_self":              true,
+	// Google::Protobuf message
+	"initialize": true,
+	"to_h":       true,
+	"to_json":    true,
+	"to_proto":   true,
+}
+
 // intersection between pgs.FieldType and pgs.FieldTypeElem
 type FieldType interface {
 	ProtoType() pgs.ProtoType
@@ -80,18 +140,101 @@ func RubyMessageType(entity EntityWithParent) string {
 	return fmt.Sprintf("%s::%s", RubyPackage(entity.File()), strings.Join(names, "::"))
 }
 
+// RubyExtensionName returns the name an extension is exposed under in the
+// package's Extensions module, prefixed by any messages it is nested in. The
+// prefixes are joined with a double underscore, so that `Foo.bar` doesn't
+// end up with the same name as a top-level `foo_bar`.
+func RubyExtensionName(ext pgs.Extension) string {
+	names := []string{ext.Name().String()}
+	outer, ok := ext.DefinedIn().(pgs.Message)
+	for ok {
+		names = append([]string{outer.Name().LowerSnakeCase().String()}, names...)
+		outer, ok = outer.Parent().(pgs.Message)
+	}
+	return strings.Join(names, "__")
+}
+
+func RubyExtensionConstant(ext pgs.Extension) string {
+	return strings.ToUpper(RubyExtensionName(ext))
+}
+
+// RubyExtensionLookupName returns the name to look the extension up by in the
+// DescriptorPool.
+func RubyExtensionLookupName(ext pgs.Extension) string {
+	return strings.TrimPrefix(ext.FullyQualifiedName(), ".")
+}
+
+// RubyPackageModules returns every module that has to exist before the
+// package module can be opened with the compact `A::B` syntax.
+func RubyPackageModules(file pgs.File) []string {
+	parts := strings.Split(RubyPackage(file), "::")
+	modules := make([]string, len(parts))
+	for i := range parts {
+		modules[i] = strings.Join(parts[:i+1], "::")
+	}
+	return modules
+}
+
+// ReservedFieldName returns true when the field's getter clashes with a method
+// every message already has. Those fields can only be read with `msg["name"]`.
+func ReservedFieldName(field pgs.Field) bool {
+	return reservedMethodNames[field.Name().String()]
+}
+
+// DerivedMethodClash returns true when the message has another field named
+// like the `<field><suffix>` accessor derived from field. The runtime resolves
+// that name to the other field, so the derived accessor can't be called.
+func DerivedMethodClash(field pgs.Field, suffix string) bool {
+	name := field.Name().String() + suffix
+	for _, other := range field.Message().Fields() {
+		if other.Name().String() == name {
+			return true
+		}
+	}
+	return false
+}
+
 func RubyGetterFieldType(field pgs.Field, genericContainers bool) string {
 	return rubyFieldType(field, methodTypeGetter, genericContainers)
 }
 
-func RubySetterFieldType(field pgs.Field, genericContainers bool) string {
-	return rubyFieldType(field, methodTypeSetter, genericContainers)
+func RubySetterFieldType(field pgs.Field, genericContainers bool, runtimeVersion int) string {
+	rubyType := rubyFieldType(field, methodTypeSetter, genericContainers)
+
+	// google-protobuf 4 converts a plain array assigned to a repeated field
+	t := field.Type()
+	if runtimeVersion >= 4 && t.IsRepeated() {
+		value := rubyProtoTypeElem(field, t.Element(), methodTypeSetter)
+		return fmt.Sprintf("T.any(%s, T::Array[%s])", rubyType, value)
+	}
+	return rubyType
 }
 
+// RubyInitializerFieldType ignores use_generic_proto_containers: the runtime
+// raises an ArgumentError unless repeated and map fields are initialized with
+// a plain Array and Hash, so a RepeatedField or Map must be converted first.
 func RubyInitializerFieldType(field pgs.Field) string {
 	return rubyFieldType(field, methodTypeInitializer, false)
 }
 
+// RubyInitializerFieldsType returns a type accepting the initializer value of
+// any of the given fields
+func RubyInitializerFieldsType(fields []pgs.Field) string {
+	types := make([]string, 0, len(fields))
+	seen := make(map[string]bool)
+	for _, field := range fields {
+		rubyType := RubyInitializerFieldType(field)
+		if !seen[rubyType] {
+			seen[rubyType] = true
+			types = append(types, rubyType)
+		}
+	}
+	if len(types) == 1 {
+		return types[0]
+	}
+	return fmt.Sprintf("T.any(%s)", strings.Join(types, ", "))
+}
+
 func rubyFieldType(field pgs.Field, mt methodType, genericContainers bool) string {
 	var rubyType string
 
@@ -106,8 +249,9 @@ func rubyFieldType(field pgs.Field, mt methodType, genericContainers bool) strin
 	}
 
 	// initializer fields can be passed a `nil` value for all field types
+	// except proto2 required fields that aren't messages
 	// messages are already wrapped so we skip those
-	if mt == methodTypeInitializer && (t.IsMap() || t.IsRepeated() || t.ProtoType() != pgs.MessageT) {
+	if mt == methodTypeInitializer && !Required(field) && (t.IsMap() || t.IsRepeated() || t.ProtoType() != pgs.MessageT) {
 		return fmt.Sprintf("T.nilable(%s)", rubyType)
 	}
 
@@ -120,12 +264,15 @@ func rubyFieldMapType(field pgs.Field, ft pgs.FieldType, mt methodType, genericC
 		return "::Google::Protobuf::Map"
 	}
 
-	key := rubyProtoTypeElem(field, ft.Key(), mt)
-	value := rubyProtoTypeElem(field, ft.Element(), mt)
-
-	if genericContainers {
+	if genericContainers && mt != methodTypeInitializer {
+		// the container holds the converted values, whatever it was assigned
+		key := rubyProtoTypeElem(field, ft.Key(), methodTypeGetter)
+		value := rubyProtoTypeElem(field, ft.Element(), methodTypeGetter)
 		return fmt.Sprintf("::Google::Protobuf::Map[%s, %s]", key, value)
 	}
+
+	key := rubyProtoTypeElem(field, ft.Key(), mt)
+	value := rubyProtoTypeElem(field, ft.Element(), mt)
 	return fmt.Sprintf("T::Hash[%s, %s]", key, value)
 }
 
@@ -137,14 +284,89 @@ func rubyFieldRepeatedType(field pgs.Field, ft pgs.FieldType, mt methodType, gen
 		return "::Google::Protobuf::RepeatedField"
 	}
 
-	value := rubyProtoTypeElem(field, ft.Element(), mt)
-
-	if genericContainers {
+	if genericContainers && mt != methodTypeInitializer {
+		// the container holds the converted values, whatever it was assigned
+		value := rubyProtoTypeElem(field, ft.Element(), methodTypeGetter)
 		return fmt.Sprintf("::Google::Protobuf::RepeatedField[%s]", value)
 	}
+
+	value := rubyProtoTypeElem(field, ft.Element(), mt)
 	return fmt.Sprintf("T::Array[%s]", value)
 }
 
+// RubyOneOfCases lists the symbols the oneof's case accessor can return
+func RubyOneOfCases(oneOf pgs.OneOf) string {
+	cases := make([]string, len(oneOf.Fields()))
+	for i, field := range oneOf.Fields() {
+		cases[i] = fmt.Sprintf(":%s", field.Name().String())
+	}
+	return strings.Join(cases, ", ")
+}
+
+// RubyEnumConstFieldType returns the type of the `<field>_const` accessor the
+// runtime defines for enum fields, which reads the raw enum number. It returns
+// an empty string for every other field.
+func RubyEnumConstFieldType(field pgs.Field) string {
+	t := field.Type()
+	if t.IsMap() {
+		return ""
+	}
+	if t.IsRepeated() {
+		if t.Element().ProtoType() == pgs.EnumT {
+			return "T::Array[Integer]"
+		}
+		return ""
+	}
+	if t.ProtoType() == pgs.EnumT {
+		return "Integer"
+	}
+	return ""
+}
+
+// RubyWrapperValueType returns the unwrapped type of a singular wrapper
+// well-known type field, or an empty string for every other field.
+func RubyWrapperValueType(field pgs.Field) string {
+	t := field.Type()
+	if t.IsRepeated() || t.IsMap() || !t.IsEmbed() {
+		return ""
+	}
+	return wrapperValueTypes[t.Embed().FullyQualifiedName()]
+}
+
+// RubySorbetEnumType returns the name of the T::Enum companion generated for
+// enum when use_sorbet_enums is set.
+func RubySorbetEnumType(enum pgs.Enum) string {
+	return RubyMessageType(enum) + "::SorbetEnum"
+}
+
+// RubySorbetEnumFieldType returns the type of the `<field>_as_enum` getter of
+// singular and repeated enum fields, or an empty string for every other field.
+// The runtime keeps unknown values of open enums, which have no T::Enum value,
+// while closed enums only ever hold one of their values.
+func RubySorbetEnumFieldType(field pgs.Field) string {
+	t := field.Type()
+	if t.IsMap() {
+		return ""
+	}
+	if t.IsRepeated() {
+		if t.Element().ProtoType() == pgs.EnumT {
+			return fmt.Sprintf("T::Array[%s]", rubySorbetEnumValueType(t.Element().Enum()))
+		}
+		return ""
+	}
+	if t.ProtoType() == pgs.EnumT {
+		return rubySorbetEnumValueType(t.Enum())
+	}
+	return ""
+}
+
+func rubySorbetEnumValueType(enum pgs.Enum) string {
+	if ClosedEnum(enum) {
+		return RubySorbetEnumType(enum)
+	}
+	return fmt.Sprintf("T.nilable(%s)", RubySorbetEnumType(enum))
+}
+
 func RubyFieldValue(field pgs.Field) string {
 	t := field.Type()
 	if t.IsMap() {
@@ -167,9 +389,20 @@ func rubyProtoTypeElem(field pgs.Field, ft FieldType, mt methodType) string {
 		return "Integer"
 	}
 	if pt.IsNumeric() {
-		return "Float"
+		// integers are converted to floats on assignment
+		if mt == methodTypeGetter {
+			return "Float"
+		}
+		return "T.any(Float, Integer)"
 	}
-	if pt == pgs.StringT || pt == pgs.BytesT {
+	if pt == pgs.StringT {
+		// symbols are converted to strings on assignment
+		if mt == methodTypeGetter {
+			return "String"
+		}
+		return "T.any(String, Symbol)"
+	}
+	if pt == pgs.BytesT {
 		return "String"
 	}
 	if pt == pgs.BoolT {
@@ -182,13 +415,44 @@ func rubyProtoTypeElem(field pgs.Field, ft FieldType, mt methodType) string {
 		return "T.any(Symbol, String, Integer)"
 	}
 	if pt == pgs.MessageT {
-		return fmt.Sprintf("T.nilable(%s)", RubyMessageType(ft.Embed()))
+		// nil leaves a message field unset, which the initializer defaults
+		// to even for required fields
+		return fmt.Sprintf("T.nilable(%s)", rubyMessageCoercionType(ft.Embed(), mt))
 	}
 	log.Panicf("Unsupported field type for field: %v\n", field.Name().String())
 	return ""
 }
 
+// rubyMessageCoercionType returns every type the runtime converts to the
+// message when it is assigned to a field.
+func rubyMessageCoercionType(message pgs.Message, mt methodType) string {
+	types := []string{RubyMessageType(message)}
+	if mt == methodTypeGetter {
+		return types[0]
+	}
+
+	switch message.FullyQualifiedName() {
+	case ".google.protobuf.Timestamp":
+		types = append(types, "Time")
+	case ".google.protobuf.Duration":
+		types = append(types, "Numeric")
+	}
+	// only the initializer builds messages out of hashes
+	if mt == methodTypeInitializer {
+		types = append(types, "T::Hash[T.untyped, T.untyped]")
+	}
+
+	if len(types) == 1 {
+		return types[0]
+	}
+	return fmt.Sprintf("T.any(%s)", strings.Join(types, ", "))
+}
+
 func rubyProtoTypeValue(field pgs.Field, ft FieldType) string {
+	if field.Descriptor().DefaultValue != nil {
+		return rubyProtoDefaultValue(field, ft)
+	}
+
 	pt := ft.ProtoType()
 	if pt.IsInt() {
 		return "0"
@@ -212,6 +476,29 @@ func rubyProtoTypeValue(field pgs.Field, ft FieldType) string {
 	return ""
 }
 
+// rubyProtoDefaultValue renders an explicit proto2 `[default = ...]` option
+func rubyProtoDefaultValue(field pgs.Field, ft FieldType) string {
+	value := field.Descriptor().GetDefaultValue()
+	pt := ft.ProtoType()
+	if pt.IsInt() || pt == pgs.BoolT {
+		return value
+	}
+	if pt.IsNumeric() {
+		return rubyFloatLiteral(value)
+	}
+	if pt == pgs.StringT {
+		return rubyStringLiteral(value, false)
+	}
+	if pt == pgs.BytesT {
+		return rubyStringLiteral(unescapeCBytes(value), true)
+	}
+	if pt == pgs.EnumT {
+		return fmt.Sprintf(":%s", value)
+	}
+	log.Panicf("Unsupported default value for field: %v\n", field.Name().String())
+	return ""
+}
+
 func rubyMapType(ft FieldType) string {
 	switch ft.ProtoType() {
 	case pgs.DoubleT:
@@ -270,6 +557,34 @@ func RubyMethodReturnType(method pgs.Method) string {
 	return rubyMethodType(method.Output(), method.ServerStreaming())
 }
 
+// RubyParam is a parameter of a generated method signature
+type RubyParam struct {
+	Name string
+	Type string
+}
+
+// RubyHandlerParams returns the arguments grpc-ruby passes to the server
+// handler of method, which depend on its streaming kind. Client streaming
+// handlers only get the call, and read the requests with each_remote_read.
+func RubyHandlerParams(method pgs.Method) []RubyParam {
+	request := RubyMessageType(method.Input())
+	switch {
+	case method.ClientStreaming() && method.ServerStreaming():
+		return []RubyParam{
+			{Name: "requests", Type: fmt.Sprintf("T::Enumerable[%s]", request)},
+			{Name: "call", Type: "::GRPC::ActiveCall::MultiReqView"},
+		}
+	case method.ClientStreaming():
+		return []RubyParam{
+			{Name: "call", Type: "::GRPC::ActiveCall::MultiReqView"},
+		}
+	}
+	return []RubyParam{
+		{Name: "request", Type: request},
+		{Name: "call", Type: "::GRPC::ActiveCall::SingleReqView"},
+	}
+}
+
 func rubyMethodType(message pgs.Message, streaming bool) string {
 	t := RubyMessageType(message)
 	if streaming {
@@ -278,6 +593,128 @@ func rubyMethodType(message pgs.Message, streaming bool) string {
 	return t
 }
 
+// RubyEnumValueName returns the name of the constant the runtime defines for
+// an enum value. Names starting with a lowercase letter get that letter
+// capitalized, and names that don't start with a letter at all have no
+// constant, in which case an empty string is returned.
+// See: https://github.com/protocolbuffers/protobuf/blob/v25.0/ruby/ext/google/protobuf_c/defs.c
 func RubyEnumValueName(name pgs.Name) string {
-	return strings.Title(string(name))
+	s := string(name)
+	if s == "" {
+		return ""
+	}
+	if s[0] >= 'a' && s[0] <= 'z' {
+		return strings.ToUpper(s[:1]) + s[1:]
+	}
+	if s[0] < 'A' || s[0] > 'Z' {
+		return ""
+	}
+	return s
+}
+
+// RubyEnumValueShadowed returns true when a later value of the same enum ends
+// up with the same constant name, so that the runtime overwrites this one.
+func RubyEnumValueShadowed(value pgs.EnumValue) bool {
+	name := RubyEnumValueName(value.Name())
+	values := value.Enum().Values()
+	for i, other := range values {
+		if other == value {
+			for _, later := range values[i+1:] {
+				if RubyEnumValueName(later.Name()) == name {
+					return true
+				}
+			}
+			break
+		}
+	}
+	return false
+}
+
+// RubyToHType returns the shape of the hash `to_h` builds out of message,
+// declared as the message's ToHShape type alias. Fields the runtime may leave
+// out of the hash are nilable: those with presence, and the empty repeated and
+// map fields of proto2 messages. google-protobuf 4 only adds the fields that
+// are set (Message_CreateHash iterates with upb_Message_Next), so every field
+// is nilable with runtimeVersion 4. Other messages refer to
+// their own alias, except for well-known types, which have no RBI of their own
+// and are expanded in place. Sorbet rejects recursive type aliases, so a
+// message that leads back to itself falls back to an untyped hash.
+func RubyToHType(message pgs.Message, runtimeVersion int) string {
+	return rubyToHShape(message, message, runtimeVersion, make(map[string]bool))
+}
+
+func rubyToHShape(root pgs.Message, message pgs.Message, runtimeVersion int, seen map[string]bool) string {
+	name := message.FullyQualifiedName()
+	if seen[name] || len(message.Fields()) == 0 {
+		return "T::Hash[Symbol, T.untyped]"
+	}
+	seen[name] = true
+	defer delete(seen, name)
+
+	omitsEmpty := proto2Syntax(message.File())
+	entries := make([]string, len(message.Fields()))
+	for i, field := range message.Fields() {
+		var rubyType string
+		t := field.Type()
+		if t.IsMap() {
+			key := rubyProtoTypeElem(field, t.Key(), methodTypeGetter)
+			rubyType = fmt.Sprintf("T::Hash[%s, %s]", key, rubyToHElem(root, field, t.Element(), runtimeVersion, seen))
+		} else if t.IsRepeated() {
+			rubyType = fmt.Sprintf("T::Array[%s]", rubyToHElem(root, field, t.Element(), runtimeVersion, seen))
+		} else {
+			rubyType = rubyToHElem(root, field, t, runtimeVersion, seen)
+		}
+		if runtimeVersion >= 4 || HasPresence(field) || (omitsEmpty && (t.IsMap() || t.IsRepeated())) {
+			rubyType = fmt.Sprintf("T.nilable(%s)", rubyType)
+		}
+		entries[i] = fmt.Sprintf("%s: %s", field.Name().String(), rubyType)
+	}
+	return fmt.Sprintf("{%s}", strings.Join(entries, ", "))
+}
+
+// proto2Syntax returns true for files declared with `syntax = "proto2"`, or
+// without any syntax, which the runtime's `to_h` treats apart from the others.
+func proto2Syntax(file pgs.File) bool {
+	syntax := file.Descriptor().GetSyntax()
+	return syntax == "" || syntax == "proto2"
+}
+
+func rubyToHElem(root pgs.Message, field pgs.Field, ft FieldType, runtimeVersion int, seen map[string]bool) string {
+	if ft.ProtoType() != pgs.MessageT {
+		return rubyProtoTypeElem(field, ft, methodTypeGetter)
+	}
+	embed := ft.Embed()
+	if embed.Package().ProtoName().String() == "google.protobuf" {
+		return rubyToHShape(root, embed, runtimeVersion, seen)
+	}
+	if embed.FullyQualifiedName() == root.FullyQualifiedName() || leadsTo(embed, root, make(map[string]bool)) {
+		return "T::Hash[Symbol, T.untyped]"
+	}
+	return RubyMessageType(embed) + "::ToHShape"
+}
+
+// leadsTo returns true when one of the fields of message, or of the messages
+// it embeds, is a target message.
+func leadsTo(message pgs.Message, target pgs.Message, visited map[string]bool) bool {
+	if visited[message.FullyQualifiedName()] {
+		return false
+	}
+	visited[message.FullyQualifiedName()] = true
+
+	for _, field := range message.Fields() {
+		t := field.Type()
+		var embed pgs.Message
+		if t.IsMap() || t.IsRepeated() {
+			embed = t.Element().Embed()
+		} else {
+			embed = t.Embed()
+		}
+		if embed == nil {
+			continue
+		}
+		if embed.FullyQualifiedName() == target.FullyQualifiedName() || leadsTo(embed, target, visited) {
+			return true
+		}
+	}
+	return false
 }
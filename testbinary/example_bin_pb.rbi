@@ -8,9 +8,9 @@ class Example::Request
 
   sig do
     params(
-      name: T.nilable(String),
-      nicknames: T.nilable(T::Array[String]),
-      attributes: T.nilable(T::Hash[String, String])
+      name: T.nilable(T.any(String, Symbol)),
+      nicknames: T.nilable(T::Array[T.any(String, Symbol)]),
+      attributes: T.nilable(T::Hash[T.any(String, Symbol), T.any(String, Symbol)])
     ).void
   end
   def initialize(
@@ -24,7 +24,7 @@ class Example::Request
   def name
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def name=(value)
   end
 
@@ -68,6 +68,20 @@ class Example::Request
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Example::Request) }
   def self.decode(str)
   end
@@ -76,12 +90,24 @@ class Example::Request
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Example::Request) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Example::Request)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Example::Request, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Example::Request,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -95,7 +121,7 @@ class Example::Response
 
   sig do
     params(
-      greeting: T.nilable(String)
+      greeting: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -107,7 +133,7 @@ class Example::Response
   def greeting
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def greeting=(value)
   end
 
@@ -127,6 +153,20 @@ class Example::Response
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Example::Response) }
   def self.decode(str)
   end
@@ -135,12 +175,24 @@ class Example::Response
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Example::Response) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Example::Response)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Example::Response, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Example::Response,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
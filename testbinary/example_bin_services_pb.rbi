@@ -5,6 +5,59 @@
 module Example::Greeter
   class Service
     include ::GRPC::GenericService
+    extend T::Helpers
+
+    abstract!
+
+    sig { returns(String) }
+    def self.service_name
+    end
+
+    sig { returns(Symbol) }
+    def self.marshal_class_method
+    end
+
+    sig { returns(Symbol) }
+    def self.unmarshal_class_method
+    end
+
+    sig { returns(T::Hash[Symbol, ::GRPC::RpcDesc]) }
+    def self.rpc_descs
+    end
+
+    # Builds a new stub class on every call, the Stub constant holds one of them.
+    sig { returns(T.class_of(::GRPC::ClientStub)) }
+    def self.rpc_stub_class
+    end
+
+    sig do
+      abstract.params(
+        request: Example::Request,
+        call: ::GRPC::ActiveCall::SingleReqView
+      ).returns(Example::Response)
+    end
+    def hello(request, call)
+    end
+  end
+
+  # The descriptors of the service's RPCs, as found in Service.rpc_descs
+  module RpcDescs
+    HELLO = T.let(T.unsafe(nil), ::GRPC::RpcDesc)
+  end
+
+  module Interface
+    extend T::Helpers
+
+    interface!
+
+    sig do
+      abstract.params(
+        request: Example::Request,
+        call: ::GRPC::ActiveCall::SingleReqView
+      ).returns(Example::Response)
+    end
+    def hello(request, call)
+    end
   end
 
   class Stub < ::GRPC::ClientStub
@@ -12,18 +65,39 @@ module Example::Greeter
       params(
         host: String,
         creds: T.any(::GRPC::Core::ChannelCredentials, Symbol),
-        kw: T.untyped,
+        channel_override: T.nilable(::GRPC::Core::Channel),
+        timeout: T.nilable(Numeric),
+        propagate_mask: T.nilable(Integer),
+        channel_args: T::Hash[String, T.any(String, Integer)],
+        interceptors: T::Array[::GRPC::ClientInterceptor]
       ).void
     end
-    def initialize(host, creds, **kw)
+    def initialize(host, creds, channel_override: nil, timeout: nil, propagate_mask: nil, channel_args: {}, interceptors: [])
     end
 
     sig do
       params(
-        request: Example::Request
+        request: Example::Request,
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials)
       ).returns(Example::Response)
     end
-    def hello(request)
+    def hello(request, metadata: {}, deadline: nil, parent: nil, credentials: nil)
+    end
+
+    # Starts the call like hello(request, return_op: true), without waiting for it.
+    sig do
+      params(
+        request: Example::Request,
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials)
+      ).returns(::GRPC::ActiveCall::Operation)
+    end
+    def hello_op(request, metadata: {}, deadline: nil, parent: nil, credentials: nil)
     end
   end
 end
@@ -3,15 +3,26 @@
 # typed: strict
 
 class Example::Broken_field_name < ::Google::Protobuf::AbstractMessage
-  # Constants of the form Constant_1 are invalid. We've declined to type this as a result, taking a hash instead.
-  sig { params(args: T::Hash[T.untyped, T.untyped]).void }
-  def initialize(args); end
+  # Fields of the form Field_1 aren't valid parameter names, so they are taken through _kwargs instead.
+  sig do
+    params(
+      name: T.nilable(T.any(String, Symbol)),
+      kwargs: T.nilable(T::Boolean),
+      _kwargs: T.any(T.nilable(T.any(String, Symbol)), T.nilable(Integer))
+    ).void
+  end
+  def initialize(
+    name: "",
+    kwargs: false,
+    **_kwargs
+  )
+  end
 
   sig { returns(String) }
   def name
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def name=(value)
   end
 
@@ -23,11 +34,35 @@ class Example::Broken_field_name < ::Google::Protobuf::AbstractMessage
   def Field_name_1
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def Field_name_1=(value)
   end
 
   sig { void }
   def clear_Field_name_1
   end
+
+  sig { returns(Integer) }
+  def Field_name_2
+  end
+
+  sig { params(value: Integer).void }
+  def Field_name_2=(value)
+  end
+
+  sig { void }
+  def clear_Field_name_2
+  end
+
+  sig { returns(T::Boolean) }
+  def kwargs
+  end
+
+  sig { params(value: T::Boolean).void }
+  def kwargs=(value)
+  end
+
+  sig { void }
+  def clear_kwargs
+  end
 end
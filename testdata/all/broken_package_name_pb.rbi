@@ -5,7 +5,7 @@
 class Package2test::Message2test < ::Google::Protobuf::AbstractMessage
   sig do
     params(
-      field2test: T.nilable(String)
+      field2test: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -17,7 +17,7 @@ class Package2test::Message2test < ::Google::Protobuf::AbstractMessage
   def field2test
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def field2test=(value)
   end
 
@@ -6,9 +6,9 @@
 class Example::Request < ::Google::Protobuf::AbstractMessage
   sig do
     params(
-      name: T.nilable(String),
-      nicknames: T.nilable(T::Array[String]),
-      attributes: T.nilable(T::Hash[String, String])
+      name: T.nilable(T.any(String, Symbol)),
+      nicknames: T.nilable(T::Array[T.any(String, Symbol)]),
+      attributes: T.nilable(T::Hash[T.any(String, Symbol), T.any(String, Symbol)])
     ).void
   end
   def initialize(
@@ -24,7 +24,7 @@ class Example::Request < ::Google::Protobuf::AbstractMessage
   end
 
   # some description for name field
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def name=(value)
   end
 
@@ -69,7 +69,7 @@ end
 class Example::Response < ::Google::Protobuf::AbstractMessage
   sig do
     params(
-      greeting: T.nilable(String)
+      greeting: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -83,7 +83,7 @@ class Example::Response < ::Google::Protobuf::AbstractMessage
   end
 
   # some description for greeting field
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def greeting=(value)
   end
 
@@ -5,7 +5,7 @@
 class Example::Lowercase < ::Google::Protobuf::AbstractMessage
   sig do
     params(
-      example_proto_field: T.nilable(String)
+      example_proto_field: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -17,7 +17,7 @@ class Example::Lowercase < ::Google::Protobuf::AbstractMessage
   def example_proto_field
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def example_proto_field=(value)
   end
 
@@ -29,7 +29,7 @@ end
 class Example::Lowercase_with_underscores < ::Google::Protobuf::AbstractMessage
   sig do
     params(
-      example_proto_field: T.nilable(String)
+      example_proto_field: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -41,7 +41,7 @@ class Example::Lowercase_with_underscores < ::Google::Protobuf::AbstractMessage
   def example_proto_field
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def example_proto_field=(value)
   end
 
@@ -34,8 +34,8 @@ end
 class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   sig do
     params(
-      double_value: T.nilable(Float),
-      float_value: T.nilable(Float),
+      double_value: T.nilable(T.any(Float, Integer)),
+      float_value: T.nilable(T.any(Float, Integer)),
       int32_value: T.nilable(Integer),
       int64_value: T.nilable(Integer),
       uint32_value: T.nilable(Integer),
@@ -47,21 +47,21 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
       sfixed32_value: T.nilable(Integer),
       sfixed64_value: T.nilable(Integer),
       bool_value: T.nilable(T::Boolean),
-      string_value: T.nilable(String),
+      string_value: T.nilable(T.any(String, Symbol)),
       bytes_value: T.nilable(String),
       enum_value: T.nilable(T.any(Symbol, String, Integer)),
       alias_enum_value: T.nilable(T.any(Symbol, String, Integer)),
-      nested_value: T.nilable(Testdata::Subdir::IntegerMessage),
-      repeated_nested_value: T.nilable(T::Array[T.nilable(Testdata::Subdir::IntegerMessage)]),
+      nested_value: T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped])),
+      repeated_nested_value: T.nilable(T::Array[T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped]))]),
       repeated_int32_value: T.nilable(T::Array[Integer]),
       repeated_enum: T.nilable(T::Array[T.any(Symbol, String, Integer)]),
-      inner_value: T.nilable(Testdata::Subdir::AllTypes::InnerMessage),
-      inner_nested_value: T.nilable(Testdata::Subdir::IntegerMessage::InnerNestedMessage),
-      name: T.nilable(String),
+      inner_value: T.nilable(T.any(Testdata::Subdir::AllTypes::InnerMessage, T::Hash[T.untyped, T.untyped])),
+      inner_nested_value: T.nilable(T.any(Testdata::Subdir::IntegerMessage::InnerNestedMessage, T::Hash[T.untyped, T.untyped])),
+      name: T.nilable(T.any(String, Symbol)),
       sub_message: T.nilable(T::Boolean),
-      string_map_value: T.nilable(T::Hash[String, T.nilable(Testdata::Subdir::IntegerMessage)]),
-      int32_map_value: T.nilable(T::Hash[Integer, T.nilable(Testdata::Subdir::IntegerMessage)]),
-      enum_map_value: T.nilable(T::Hash[String, T.any(Symbol, String, Integer)]),
+      string_map_value: T.nilable(T::Hash[T.any(String, Symbol), T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped]))]),
+      int32_map_value: T.nilable(T::Hash[Integer, T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped]))]),
+      enum_map_value: T.nilable(T::Hash[T.any(String, Symbol), T.any(Symbol, String, Integer)]),
       optional_bool: T.nilable(T::Boolean)
     ).void
   end
@@ -102,7 +102,7 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def double_value
   end
 
-  sig { params(value: Float).void }
+  sig { params(value: T.any(Float, Integer)).void }
   def double_value=(value)
   end
 
@@ -114,7 +114,7 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def float_value
   end
 
-  sig { params(value: Float).void }
+  sig { params(value: T.any(Float, Integer)).void }
   def float_value=(value)
   end
 
@@ -258,7 +258,7 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def string_value
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def string_value=(value)
   end
 
@@ -290,6 +290,18 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_enum_value
   end
 
+  sig { returns(Integer) }
+  def enum_value_const
+  end
+
+  sig { returns(T.nilable(Testdata::Subdir::AllTypes::Corpus::SorbetEnum)) }
+  def enum_value_as_enum
+  end
+
+  sig { params(value: Testdata::Subdir::AllTypes::Corpus::SorbetEnum).void }
+  def enum_value_as_enum=(value)
+  end
+
   sig { returns(T.any(Symbol, Integer)) }
   def alias_enum_value
   end
@@ -302,6 +314,18 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_alias_enum_value
   end
 
+  sig { returns(Integer) }
+  def alias_enum_value_const
+  end
+
+  sig { returns(T.nilable(Testdata::Subdir::AllTypes::EnumAllowingAlias::SorbetEnum)) }
+  def alias_enum_value_as_enum
+  end
+
+  sig { params(value: Testdata::Subdir::AllTypes::EnumAllowingAlias::SorbetEnum).void }
+  def alias_enum_value_as_enum=(value)
+  end
+
   sig { returns(T.nilable(Testdata::Subdir::IntegerMessage)) }
   def nested_value
   end
@@ -314,6 +338,10 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_nested_value
   end
 
+  sig { returns(T::Boolean) }
+  def has_nested_value?
+  end
+
   sig { returns(::Google::Protobuf::RepeatedField[T.nilable(Testdata::Subdir::IntegerMessage)]) }
   def repeated_nested_value
   end
@@ -342,7 +370,7 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def repeated_enum
   end
 
-  sig { params(value: ::Google::Protobuf::RepeatedField[T.any(Symbol, String, Integer)]).void }
+  sig { params(value: ::Google::Protobuf::RepeatedField[T.any(Symbol, Integer)]).void }
   def repeated_enum=(value)
   end
 
@@ -350,6 +378,14 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_repeated_enum
   end
 
+  sig { returns(T::Array[Integer]) }
+  def repeated_enum_const
+  end
+
+  sig { returns(T::Array[T.nilable(Testdata::Subdir::AllTypes::Corpus::SorbetEnum)]) }
+  def repeated_enum_as_enum
+  end
+
   sig { returns(T.nilable(Testdata::Subdir::AllTypes::InnerMessage)) }
   def inner_value
   end
@@ -362,6 +398,10 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_inner_value
   end
 
+  sig { returns(T::Boolean) }
+  def has_inner_value?
+  end
+
   sig { returns(T.nilable(Testdata::Subdir::IntegerMessage::InnerNestedMessage)) }
   def inner_nested_value
   end
@@ -374,11 +414,15 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_inner_nested_value
   end
 
+  sig { returns(T::Boolean) }
+  def has_inner_nested_value?
+  end
+
   sig { returns(String) }
   def name
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def name=(value)
   end
 
@@ -386,6 +430,10 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_name
   end
 
+  sig { returns(T::Boolean) }
+  def has_name?
+  end
+
   sig { returns(T::Boolean) }
   def sub_message
   end
@@ -398,6 +446,10 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_sub_message
   end
 
+  sig { returns(T::Boolean) }
+  def has_sub_message?
+  end
+
   sig { returns(::Google::Protobuf::Map[String, T.nilable(Testdata::Subdir::IntegerMessage)]) }
   def string_map_value
   end
@@ -426,7 +478,7 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def enum_map_value
   end
 
-  sig { params(value: ::Google::Protobuf::Map[String, T.any(Symbol, String, Integer)]).void }
+  sig { params(value: ::Google::Protobuf::Map[String, T.any(Symbol, Integer)]).void }
   def enum_map_value=(value)
   end
 
@@ -450,15 +502,24 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def has_optional_bool?
   end
 
+  # One of :name, :sub_message
   sig { returns(T.nilable(Symbol)) }
   def test_oneof
   end
+
+  sig { returns(T::Boolean) }
+  def has_test_oneof?
+  end
+
+  sig { void }
+  def clear_test_oneof
+  end
 end
 
 class Testdata::Subdir::IntegerMessage::InnerNestedMessage < ::Google::Protobuf::AbstractMessage
   sig do
     params(
-      value: T.nilable(Float)
+      value: T.nilable(T.any(Float, Integer))
     ).void
   end
   def initialize(
@@ -470,7 +531,7 @@ class Testdata::Subdir::IntegerMessage::InnerNestedMessage < ::Google::Protobuf:
   def value
   end
 
-  sig { params(value: Float).void }
+  sig { params(value: T.any(Float, Integer)).void }
   def value=(value)
   end
 
@@ -487,7 +548,7 @@ end
 class Testdata::Subdir::AllTypes::InnerMessage < ::Google::Protobuf::AbstractMessage
   sig do
     params(
-      value: T.nilable(String)
+      value: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -499,7 +560,7 @@ class Testdata::Subdir::AllTypes::InnerMessage < ::Google::Protobuf::AbstractMes
   def value
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def value=(value)
   end
 
@@ -532,6 +593,32 @@ module Testdata::Subdir::AllTypes::Corpus
   end
 end
 
+class Testdata::Subdir::AllTypes::Corpus::SorbetEnum < T::Enum
+  enums do
+    UNIVERSAL = new(:UNIVERSAL)
+    WEB = new(:WEB)
+    IMAGES = new(:IMAGES)
+    LOCAL = new(:LOCAL)
+    NEWS = new(:NEWS)
+    PRODUCTS = new(:PRODUCTS)
+    VIDEO = new(:VIDEO)
+    END = new(:END)
+    Lower = new(:lower)
+  end
+
+  sig { params(value: T.any(Symbol, Integer)).returns(T.nilable(Testdata::Subdir::AllTypes::Corpus::SorbetEnum)) }
+  def self.from_proto(value)
+  end
+
+  sig { returns(Symbol) }
+  def to_proto
+  end
+
+  sig { returns(Integer) }
+  def to_i
+  end
+end
+
 module Testdata::Subdir::AllTypes::EnumAllowingAlias
   self::UNKNOWN = T.let(0, Integer)
   self::STARTED = T.let(1, Integer)
@@ -549,3 +636,23 @@ module Testdata::Subdir::AllTypes::EnumAllowingAlias
   def self.descriptor
   end
 end
+
+class Testdata::Subdir::AllTypes::EnumAllowingAlias::SorbetEnum < T::Enum
+  enums do
+    UNKNOWN = new(:UNKNOWN)
+    STARTED = new(:STARTED)
+    RUNNING = new(:RUNNING)
+  end
+
+  sig { params(value: T.any(Symbol, Integer)).returns(T.nilable(Testdata::Subdir::AllTypes::EnumAllowingAlias::SorbetEnum)) }
+  def self.from_proto(value)
+  end
+
+  sig { returns(Symbol) }
+  def to_proto
+  end
+
+  sig { returns(Integer) }
+  def to_i
+  end
+end
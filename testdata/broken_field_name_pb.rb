@@ -1,16 +1,14 @@
+# frozen_string_literal: true
 # Generated by the protocol buffer compiler.  DO NOT EDIT!
 # source: broken_field_name.proto
 
 require 'google/protobuf'
 
-Google::Protobuf::DescriptorPool.generated_pool.build do
-  add_file("broken_field_name.proto", :syntax => :proto3) do
-    add_message "example.broken_field_name" do
-      optional :name, :string, 1
-      optional :Field_name_1, :string, 2
-    end
-  end
-end
+
+descriptor_data = "\n\x17\x62roken_field_name.proto\x12\x07\x65xample\"]\n\x11\x62roken_field_name\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x14\n\x0c\x46ield_name_1\x18\x02 \x01(\t\x12\x14\n\x0c\x46ield_name_2\x18\x03 \x01(\x05\x12\x0e\n\x06kwargs\x18\x04 \x01(\x08\x62\x06proto3"
+
+pool = Google::Protobuf::DescriptorPool.generated_pool
+pool.add_serialized_file(descriptor_data)
 
 module Example
   Broken_field_name = ::Google::Protobuf::DescriptorPool.generated_pool.lookup("example.broken_field_name").msgclass
@@ -1,15 +1,14 @@
+# frozen_string_literal: true
 # Generated by the protocol buffer compiler.  DO NOT EDIT!
 # source: broken_package_name.proto
 
 require 'google/protobuf'
 
-Google::Protobuf::DescriptorPool.generated_pool.build do
-  add_file("broken_package_name.proto", :syntax => :proto3) do
-    add_message "package2test.Message2test" do
-      optional :field2test, :string, 1
-    end
-  end
-end
+
+descriptor_data = "\n\x19\x62roken_package_name.proto\x12\x0cpackage2test\"\"\n\x0cMessage2test\x12\x12\n\nfield2test\x18\x01 \x01(\tb\x06proto3"
+
+pool = Google::Protobuf::DescriptorPool.generated_pool
+pool.add_serialized_file(descriptor_data)
 
 module Package2test
   Message2test = ::Google::Protobuf::DescriptorPool.generated_pool.lookup("package2test.Message2test").msgclass
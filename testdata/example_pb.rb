@@ -1,20 +1,14 @@
+# frozen_string_literal: true
 # Generated by the protocol buffer compiler.  DO NOT EDIT!
 # source: example.proto
 
 require 'google/protobuf'
 
-Google::Protobuf::DescriptorPool.generated_pool.build do
-  add_file("example.proto", :syntax => :proto3) do
-    add_message "example.Request" do
-      optional :name, :string, 1
-      repeated :nicknames, :string, 2
-      map :attributes, :string, :string, 3
-    end
-    add_message "example.Response" do
-      optional :greeting, :string, 1
-    end
-  end
-end
+
+descriptor_data = "\n\rexample.proto\x12\x07\x65xample\"\x93\x01\n\x07Request\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnicknames\x18\x02 \x03(\t\x12\x34\n\nattributes\x18\x03 \x03(\x0b\x32 .example.Request.AttributesEntry\x1a\x31\n\x0f\x41ttributesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x1c\n\x08Response\x12\x10\n\x08greeting\x18\x01 \x01(\t27\n\x07Greeter\x12,\n\x05Hello\x12\x10.example.Request\x1a\x11.example.Responseb\x06proto3"
+
+pool = Google::Protobuf::DescriptorPool.generated_pool
+pool.add_serialized_file(descriptor_data)
 
 module Example
   Request = ::Google::Protobuf::DescriptorPool.generated_pool.lookup("example.Request").msgclass
@@ -8,7 +8,7 @@ class Package2test::Message2test
 
   sig do
     params(
-      field2test: T.nilable(String)
+      field2test: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -20,7 +20,7 @@ class Package2test::Message2test
   def field2test
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def field2test=(value)
   end
 
@@ -9,9 +9,9 @@ class Example::Request
 
   sig do
     params(
-      name: T.nilable(String),
-      nicknames: T.nilable(T::Array[String]),
-      attributes: T.nilable(T::Hash[String, String])
+      name: T.nilable(T.any(String, Symbol)),
+      nicknames: T.nilable(T::Array[T.any(String, Symbol)]),
+      attributes: T.nilable(T::Hash[T.any(String, Symbol), T.any(String, Symbol)])
     ).void
   end
   def initialize(
@@ -27,7 +27,7 @@ class Example::Request
   end
 
   # some description for name field
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def name=(value)
   end
 
@@ -75,7 +75,7 @@ class Example::Response
 
   sig do
     params(
-      greeting: T.nilable(String)
+      greeting: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -89,7 +89,7 @@ class Example::Response
   end
 
   # some description for greeting field
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def greeting=(value)
   end
 
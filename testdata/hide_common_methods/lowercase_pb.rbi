@@ -8,7 +8,7 @@ class Example::Lowercase
 
   sig do
     params(
-      example_proto_field: T.nilable(String)
+      example_proto_field: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -20,7 +20,7 @@ class Example::Lowercase
   def example_proto_field
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def example_proto_field=(value)
   end
 
@@ -35,7 +35,7 @@ class Example::Lowercase_with_underscores
 
   sig do
     params(
-      example_proto_field: T.nilable(String)
+      example_proto_field: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -47,7 +47,7 @@ class Example::Lowercase_with_underscores
   def example_proto_field
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def example_proto_field=(value)
   end
 
@@ -43,8 +43,8 @@ class Testdata::Subdir::AllTypes
 
   sig do
     params(
-      double_value: T.nilable(Float),
-      float_value: T.nilable(Float),
+      double_value: T.nilable(T.any(Float, Integer)),
+      float_value: T.nilable(T.any(Float, Integer)),
       int32_value: T.nilable(Integer),
       int64_value: T.nilable(Integer),
       uint32_value: T.nilable(Integer),
@@ -56,21 +56,21 @@ class Testdata::Subdir::AllTypes
       sfixed32_value: T.nilable(Integer),
       sfixed64_value: T.nilable(Integer),
       bool_value: T.nilable(T::Boolean),
-      string_value: T.nilable(String),
+      string_value: T.nilable(T.any(String, Symbol)),
       bytes_value: T.nilable(String),
       enum_value: T.nilable(T.any(Symbol, String, Integer)),
       alias_enum_value: T.nilable(T.any(Symbol, String, Integer)),
-      nested_value: T.nilable(Testdata::Subdir::IntegerMessage),
-      repeated_nested_value: T.nilable(T::Array[T.nilable(Testdata::Subdir::IntegerMessage)]),
+      nested_value: T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped])),
+      repeated_nested_value: T.nilable(T::Array[T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped]))]),
       repeated_int32_value: T.nilable(T::Array[Integer]),
       repeated_enum: T.nilable(T::Array[T.any(Symbol, String, Integer)]),
-      inner_value: T.nilable(Testdata::Subdir::AllTypes::InnerMessage),
-      inner_nested_value: T.nilable(Testdata::Subdir::IntegerMessage::InnerNestedMessage),
-      name: T.nilable(String),
+      inner_value: T.nilable(T.any(Testdata::Subdir::AllTypes::InnerMessage, T::Hash[T.untyped, T.untyped])),
+      inner_nested_value: T.nilable(T.any(Testdata::Subdir::IntegerMessage::InnerNestedMessage, T::Hash[T.untyped, T.untyped])),
+      name: T.nilable(T.any(String, Symbol)),
       sub_message: T.nilable(T::Boolean),
-      string_map_value: T.nilable(T::Hash[String, T.nilable(Testdata::Subdir::IntegerMessage)]),
-      int32_map_value: T.nilable(T::Hash[Integer, T.nilable(Testdata::Subdir::IntegerMessage)]),
-      enum_map_value: T.nilable(T::Hash[String, T.any(Symbol, String, Integer)]),
+      string_map_value: T.nilable(T::Hash[T.any(String, Symbol), T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped]))]),
+      int32_map_value: T.nilable(T::Hash[Integer, T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped]))]),
+      enum_map_value: T.nilable(T::Hash[T.any(String, Symbol), T.any(Symbol, String, Integer)]),
       optional_bool: T.nilable(T::Boolean)
     ).void
   end
@@ -111,7 +111,7 @@ class Testdata::Subdir::AllTypes
   def double_value
   end
 
-  sig { params(value: Float).void }
+  sig { params(value: T.any(Float, Integer)).void }
   def double_value=(value)
   end
 
@@ -123,7 +123,7 @@ class Testdata::Subdir::AllTypes
   def float_value
   end
 
-  sig { params(value: Float).void }
+  sig { params(value: T.any(Float, Integer)).void }
   def float_value=(value)
   end
 
@@ -267,7 +267,7 @@ class Testdata::Subdir::AllTypes
   def string_value
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def string_value=(value)
   end
 
@@ -299,6 +299,10 @@ class Testdata::Subdir::AllTypes
   def clear_enum_value
   end
 
+  sig { returns(Integer) }
+  def enum_value_const
+  end
+
   sig { returns(T.any(Symbol, Integer)) }
   def alias_enum_value
   end
@@ -311,6 +315,10 @@ class Testdata::Subdir::AllTypes
   def clear_alias_enum_value
   end
 
+  sig { returns(Integer) }
+  def alias_enum_value_const
+  end
+
   sig { returns(T.nilable(Testdata::Subdir::IntegerMessage)) }
   def nested_value
   end
@@ -323,6 +331,10 @@ class Testdata::Subdir::AllTypes
   def clear_nested_value
   end
 
+  sig { returns(T::Boolean) }
+  def has_nested_value?
+  end
+
   sig { returns(T::Array[T.nilable(Testdata::Subdir::IntegerMessage)]) }
   def repeated_nested_value
   end
@@ -359,6 +371,10 @@ class Testdata::Subdir::AllTypes
   def clear_repeated_enum
   end
 
+  sig { returns(T::Array[Integer]) }
+  def repeated_enum_const
+  end
+
   sig { returns(T.nilable(Testdata::Subdir::AllTypes::InnerMessage)) }
   def inner_value
   end
@@ -371,6 +387,10 @@ class Testdata::Subdir::AllTypes
   def clear_inner_value
   end
 
+  sig { returns(T::Boolean) }
+  def has_inner_value?
+  end
+
   sig { returns(T.nilable(Testdata::Subdir::IntegerMessage::InnerNestedMessage)) }
   def inner_nested_value
   end
@@ -383,11 +403,15 @@ class Testdata::Subdir::AllTypes
   def clear_inner_nested_value
   end
 
+  sig { returns(T::Boolean) }
+  def has_inner_nested_value?
+  end
+
   sig { returns(String) }
   def name
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def name=(value)
   end
 
@@ -395,6 +419,10 @@ class Testdata::Subdir::AllTypes
   def clear_name
   end
 
+  sig { returns(T::Boolean) }
+  def has_name?
+  end
+
   sig { returns(T::Boolean) }
   def sub_message
   end
@@ -407,6 +435,10 @@ class Testdata::Subdir::AllTypes
   def clear_sub_message
   end
 
+  sig { returns(T::Boolean) }
+  def has_sub_message?
+  end
+
   sig { returns(T::Hash[String, T.nilable(Testdata::Subdir::IntegerMessage)]) }
   def string_map_value
   end
@@ -459,9 +491,18 @@ class Testdata::Subdir::AllTypes
   def has_optional_bool?
   end
 
+  # One of :name, :sub_message
   sig { returns(T.nilable(Symbol)) }
   def test_oneof
   end
+
+  sig { returns(T::Boolean) }
+  def has_test_oneof?
+  end
+
+  sig { void }
+  def clear_test_oneof
+  end
 end
 
 class Testdata::Subdir::IntegerMessage::InnerNestedMessage
@@ -470,7 +511,7 @@ class Testdata::Subdir::IntegerMessage::InnerNestedMessage
 
   sig do
     params(
-      value: T.nilable(Float)
+      value: T.nilable(T.any(Float, Integer))
     ).void
   end
   def initialize(
@@ -482,7 +523,7 @@ class Testdata::Subdir::IntegerMessage::InnerNestedMessage
   def value
   end
 
-  sig { params(value: Float).void }
+  sig { params(value: T.any(Float, Integer)).void }
   def value=(value)
   end
 
@@ -505,7 +546,7 @@ class Testdata::Subdir::AllTypes::InnerMessage
 
   sig do
     params(
-      value: T.nilable(String)
+      value: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -517,7 +558,7 @@ class Testdata::Subdir::AllTypes::InnerMessage
   def value
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def value=(value)
   end
 
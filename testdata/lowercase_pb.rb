@@ -1,18 +1,14 @@
+# frozen_string_literal: true
 # Generated by the protocol buffer compiler.  DO NOT EDIT!
 # source: lowercase.proto
 
 require 'google/protobuf'
 
-Google::Protobuf::DescriptorPool.generated_pool.build do
-  add_file("lowercase.proto", :syntax => :proto3) do
-    add_message "example.lowercase" do
-      optional :example_proto_field, :string, 1
-    end
-    add_message "example.lowercase_with_underscores" do
-      optional :example_proto_field, :string, 1
-    end
-  end
-end
+
+descriptor_data = "\n\x0flowercase.proto\x12\x07\x65xample\"(\n\tlowercase\x12\x1b\n\x13\x65xample_proto_field\x18\x01 \x01(\t\"9\n\x1alowercase_with_underscores\x12\x1b\n\x13\x65xample_proto_field\x18\x01 \x01(\tb\x06proto3"
+
+pool = Google::Protobuf::DescriptorPool.generated_pool
+pool.add_serialized_file(descriptor_data)
 
 module Example
   Lowercase = ::Google::Protobuf::DescriptorPool.generated_pool.lookup("example.lowercase").msgclass
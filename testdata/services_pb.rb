@@ -1,3 +1,4 @@
+# frozen_string_literal: true
 # Generated by the protocol buffer compiler.  DO NOT EDIT!
 # source: services.proto
 
@@ -5,10 +6,11 @@ require 'google/protobuf'
 
 require 'subdir/messages_pb'
 
-Google::Protobuf::DescriptorPool.generated_pool.build do
-  add_file("services.proto", :syntax => :proto3) do
-  end
-end
+
+descriptor_data = "\n\x0eservices.proto\x12\x08testdata\x1a\x15subdir/messages.proto2\xfb\x01\n\x11SimpleMathematics\x12J\n\x06Negate\x12\x1f.testdata.subdir.IntegerMessage\x1a\x1f.testdata.subdir.IntegerMessage\x12L\n\x06Median\x12\x1f.testdata.subdir.IntegerMessage\x1a\x1f.testdata.subdir.IntegerMessage(\x01\x12L\n\x08NegateOp\x12\x1f.testdata.subdir.IntegerMessage\x1a\x1f.testdata.subdir.IntegerMessage2\x8e\x02\n\x12\x43omplexMathematics\x12O\n\tFibonacci\x12\x1f.testdata.subdir.IntegerMessage\x1a\x1f.testdata.subdir.IntegerMessage0\x01\x12R\n\nRunningMax\x12\x1f.testdata.subdir.IntegerMessage\x1a\x1f.testdata.subdir.IntegerMessage(\x01\x30\x01\x12S\n\x0bPeriodicMax\x12\x1f.testdata.subdir.IntegerMessage\x1a\x1f.testdata.subdir.IntegerMessage(\x01\x30\x01\x62\x06proto3"
+
+pool = Google::Protobuf::DescriptorPool.generated_pool
+pool.add_serialized_file(descriptor_data)
 
 module Testdata
 end
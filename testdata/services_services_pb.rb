@@ -19,6 +19,8 @@ module Testdata
       rpc :Negate, ::Testdata::Subdir::IntegerMessage, ::Testdata::Subdir::IntegerMessage
       # Report the median of a stream of integers
       rpc :Median, stream(::Testdata::Subdir::IntegerMessage), ::Testdata::Subdir::IntegerMessage
+      # Negates the input without waiting for the result
+      rpc :NegateOp, ::Testdata::Subdir::IntegerMessage, ::Testdata::Subdir::IntegerMessage
     end
 
     Stub = Service.rpc_stub_class
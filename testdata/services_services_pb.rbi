@@ -5,6 +5,103 @@
 module Testdata::SimpleMathematics
   class Service
     include ::GRPC::GenericService
+    extend T::Helpers
+
+    abstract!
+
+    sig { returns(String) }
+    def self.service_name
+    end
+
+    sig { returns(Symbol) }
+    def self.marshal_class_method
+    end
+
+    sig { returns(Symbol) }
+    def self.unmarshal_class_method
+    end
+
+    sig { returns(T::Hash[Symbol, ::GRPC::RpcDesc]) }
+    def self.rpc_descs
+    end
+
+    # Builds a new stub class on every call, the Stub constant holds one of them.
+    sig { returns(T.class_of(::GRPC::ClientStub)) }
+    def self.rpc_stub_class
+    end
+
+    # Negates the input
+    sig do
+      abstract.params(
+        request: Testdata::Subdir::IntegerMessage,
+        call: ::GRPC::ActiveCall::SingleReqView
+      ).returns(Testdata::Subdir::IntegerMessage)
+    end
+    def negate(request, call)
+    end
+
+    # Report the median of a stream of integers
+    # The Testdata::Subdir::IntegerMessage requests are read with call.each_remote_read.
+    sig do
+      abstract.params(
+        call: ::GRPC::ActiveCall::MultiReqView
+      ).returns(Testdata::Subdir::IntegerMessage)
+    end
+    def median(call)
+    end
+
+    # Negates the input without waiting for the result
+    sig do
+      abstract.params(
+        request: Testdata::Subdir::IntegerMessage,
+        call: ::GRPC::ActiveCall::SingleReqView
+      ).returns(Testdata::Subdir::IntegerMessage)
+    end
+    def negate_op(request, call)
+    end
+  end
+
+  # The descriptors of the service's RPCs, as found in Service.rpc_descs
+  module RpcDescs
+    NEGATE = T.let(T.unsafe(nil), ::GRPC::RpcDesc)
+    MEDIAN = T.let(T.unsafe(nil), ::GRPC::RpcDesc)
+    NEGATE_OP = T.let(T.unsafe(nil), ::GRPC::RpcDesc)
+  end
+
+  module Interface
+    extend T::Helpers
+
+    interface!
+
+    # Negates the input
+    sig do
+      abstract.params(
+        request: Testdata::Subdir::IntegerMessage,
+        call: ::GRPC::ActiveCall::SingleReqView
+      ).returns(Testdata::Subdir::IntegerMessage)
+    end
+    def negate(request, call)
+    end
+
+    # Report the median of a stream of integers
+    # The Testdata::Subdir::IntegerMessage requests are read with call.each_remote_read.
+    sig do
+      abstract.params(
+        call: ::GRPC::ActiveCall::MultiReqView
+      ).returns(Testdata::Subdir::IntegerMessage)
+    end
+    def median(call)
+    end
+
+    # Negates the input without waiting for the result
+    sig do
+      abstract.params(
+        request: Testdata::Subdir::IntegerMessage,
+        call: ::GRPC::ActiveCall::SingleReqView
+      ).returns(Testdata::Subdir::IntegerMessage)
+    end
+    def negate_op(request, call)
+    end
   end
 
   class Stub < ::GRPC::ClientStub
@@ -12,28 +109,81 @@ module Testdata::SimpleMathematics
       params(
         host: String,
         creds: T.any(::GRPC::Core::ChannelCredentials, Symbol),
-        kw: T.untyped,
+        channel_override: T.nilable(::GRPC::Core::Channel),
+        timeout: T.nilable(Numeric),
+        propagate_mask: T.nilable(Integer),
+        channel_args: T::Hash[String, T.any(String, Integer)],
+        interceptors: T::Array[::GRPC::ClientInterceptor]
       ).void
     end
-    def initialize(host, creds, **kw)
+    def initialize(host, creds, channel_override: nil, timeout: nil, propagate_mask: nil, channel_args: {}, interceptors: [])
     end
 
     # Negates the input
     sig do
       params(
-        request: Testdata::Subdir::IntegerMessage
+        request: Testdata::Subdir::IntegerMessage,
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials)
+      ).returns(Testdata::Subdir::IntegerMessage)
+    end
+    def negate(request, metadata: {}, deadline: nil, parent: nil, credentials: nil)
+    end
+
+    # Report the median of a stream of integers
+    sig do
+      params(
+        request: T::Enumerable[Testdata::Subdir::IntegerMessage],
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials)
       ).returns(Testdata::Subdir::IntegerMessage)
     end
-    def negate(request)
+    def median(request, metadata: {}, deadline: nil, parent: nil, credentials: nil)
     end
 
     # Report the median of a stream of integers
+    # Starts the call like median(request, return_op: true), without waiting for it.
     sig do
       params(
-        request: T::Enumerable[Testdata::Subdir::IntegerMessage]
+        request: T::Enumerable[Testdata::Subdir::IntegerMessage],
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials)
+      ).returns(::GRPC::ActiveCall::Operation)
+    end
+    def median_op(request, metadata: {}, deadline: nil, parent: nil, credentials: nil)
+    end
+
+    # Negates the input without waiting for the result
+    sig do
+      params(
+        request: Testdata::Subdir::IntegerMessage,
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials)
       ).returns(Testdata::Subdir::IntegerMessage)
     end
-    def median(request)
+    def negate_op(request, metadata: {}, deadline: nil, parent: nil, credentials: nil)
+    end
+
+    # Negates the input without waiting for the result
+    # Starts the call like negate_op(request, return_op: true), without waiting for it.
+    sig do
+      params(
+        request: Testdata::Subdir::IntegerMessage,
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials)
+      ).returns(::GRPC::ActiveCall::Operation)
+    end
+    def negate_op_op(request, metadata: {}, deadline: nil, parent: nil, credentials: nil)
     end
   end
 end
@@ -41,6 +191,103 @@ end
 module Testdata::ComplexMathematics
   class Service
     include ::GRPC::GenericService
+    extend T::Helpers
+
+    abstract!
+
+    sig { returns(String) }
+    def self.service_name
+    end
+
+    sig { returns(Symbol) }
+    def self.marshal_class_method
+    end
+
+    sig { returns(Symbol) }
+    def self.unmarshal_class_method
+    end
+
+    sig { returns(T::Hash[Symbol, ::GRPC::RpcDesc]) }
+    def self.rpc_descs
+    end
+
+    # Builds a new stub class on every call, the Stub constant holds one of them.
+    sig { returns(T.class_of(::GRPC::ClientStub)) }
+    def self.rpc_stub_class
+    end
+
+    # Stream the first N numbers in the Fibonacci sequence
+    sig do
+      abstract.params(
+        request: Testdata::Subdir::IntegerMessage,
+        call: ::GRPC::ActiveCall::SingleReqView
+      ).returns(T::Enumerable[Testdata::Subdir::IntegerMessage])
+    end
+    def fibonacci(request, call)
+    end
+
+    # Accept a stream of integers, and report whenever a new maximum is found
+    sig do
+      abstract.params(
+        requests: T::Enumerable[Testdata::Subdir::IntegerMessage],
+        call: ::GRPC::ActiveCall::MultiReqView
+      ).returns(T::Enumerable[Testdata::Subdir::IntegerMessage])
+    end
+    def running_max(requests, call)
+    end
+
+    # Accept a stream of integers, and report the maximum every second
+    sig do
+      abstract.params(
+        requests: T::Enumerable[Testdata::Subdir::IntegerMessage],
+        call: ::GRPC::ActiveCall::MultiReqView
+      ).returns(T::Enumerable[Testdata::Subdir::IntegerMessage])
+    end
+    def periodic_max(requests, call)
+    end
+  end
+
+  # The descriptors of the service's RPCs, as found in Service.rpc_descs
+  module RpcDescs
+    FIBONACCI = T.let(T.unsafe(nil), ::GRPC::RpcDesc)
+    RUNNING_MAX = T.let(T.unsafe(nil), ::GRPC::RpcDesc)
+    PERIODIC_MAX = T.let(T.unsafe(nil), ::GRPC::RpcDesc)
+  end
+
+  module Interface
+    extend T::Helpers
+
+    interface!
+
+    # Stream the first N numbers in the Fibonacci sequence
+    sig do
+      abstract.params(
+        request: Testdata::Subdir::IntegerMessage,
+        call: ::GRPC::ActiveCall::SingleReqView
+      ).returns(T::Enumerable[Testdata::Subdir::IntegerMessage])
+    end
+    def fibonacci(request, call)
+    end
+
+    # Accept a stream of integers, and report whenever a new maximum is found
+    sig do
+      abstract.params(
+        requests: T::Enumerable[Testdata::Subdir::IntegerMessage],
+        call: ::GRPC::ActiveCall::MultiReqView
+      ).returns(T::Enumerable[Testdata::Subdir::IntegerMessage])
+    end
+    def running_max(requests, call)
+    end
+
+    # Accept a stream of integers, and report the maximum every second
+    sig do
+      abstract.params(
+        requests: T::Enumerable[Testdata::Subdir::IntegerMessage],
+        call: ::GRPC::ActiveCall::MultiReqView
+      ).returns(T::Enumerable[Testdata::Subdir::IntegerMessage])
+    end
+    def periodic_max(requests, call)
+    end
   end
 
   class Stub < ::GRPC::ClientStub
@@ -48,37 +295,125 @@ module Testdata::ComplexMathematics
       params(
         host: String,
         creds: T.any(::GRPC::Core::ChannelCredentials, Symbol),
-        kw: T.untyped,
+        channel_override: T.nilable(::GRPC::Core::Channel),
+        timeout: T.nilable(Numeric),
+        propagate_mask: T.nilable(Integer),
+        channel_args: T::Hash[String, T.any(String, Integer)],
+        interceptors: T::Array[::GRPC::ClientInterceptor]
       ).void
     end
-    def initialize(host, creds, **kw)
+    def initialize(host, creds, channel_override: nil, timeout: nil, propagate_mask: nil, channel_args: {}, interceptors: [])
     end
 
     # Stream the first N numbers in the Fibonacci sequence
     sig do
       params(
-        request: Testdata::Subdir::IntegerMessage
-      ).returns(T::Enumerable[Testdata::Subdir::IntegerMessage])
+        request: Testdata::Subdir::IntegerMessage,
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials)
+      ).returns(T::Enumerator[Testdata::Subdir::IntegerMessage])
+    end
+    sig do
+      params(
+        request: Testdata::Subdir::IntegerMessage,
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials),
+        blk: T.proc.params(response: Testdata::Subdir::IntegerMessage).void
+      ).void
     end
-    def fibonacci(request)
+    def fibonacci(request, metadata: {}, deadline: nil, parent: nil, credentials: nil, &blk)
+    end
+
+    # Stream the first N numbers in the Fibonacci sequence
+    # Starts the call like fibonacci(request, return_op: true), without waiting for it.
+    sig do
+      params(
+        request: Testdata::Subdir::IntegerMessage,
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials)
+      ).returns(::GRPC::ActiveCall::Operation)
+    end
+    def fibonacci_op(request, metadata: {}, deadline: nil, parent: nil, credentials: nil)
     end
 
     # Accept a stream of integers, and report whenever a new maximum is found
     sig do
       params(
-        request: T::Enumerable[Testdata::Subdir::IntegerMessage]
-      ).returns(T::Enumerable[Testdata::Subdir::IntegerMessage])
+        request: T::Enumerable[Testdata::Subdir::IntegerMessage],
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials)
+      ).returns(T::Enumerator[Testdata::Subdir::IntegerMessage])
+    end
+    sig do
+      params(
+        request: T::Enumerable[Testdata::Subdir::IntegerMessage],
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials),
+        blk: T.proc.params(response: Testdata::Subdir::IntegerMessage).void
+      ).void
+    end
+    def running_max(request, metadata: {}, deadline: nil, parent: nil, credentials: nil, &blk)
+    end
+
+    # Accept a stream of integers, and report whenever a new maximum is found
+    # Starts the call like running_max(request, return_op: true), without waiting for it.
+    sig do
+      params(
+        request: T::Enumerable[Testdata::Subdir::IntegerMessage],
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials)
+      ).returns(::GRPC::ActiveCall::Operation)
     end
-    def running_max(request)
+    def running_max_op(request, metadata: {}, deadline: nil, parent: nil, credentials: nil)
     end
 
     # Accept a stream of integers, and report the maximum every second
     sig do
       params(
-        request: T::Enumerable[Testdata::Subdir::IntegerMessage]
-      ).returns(T::Enumerable[Testdata::Subdir::IntegerMessage])
+        request: T::Enumerable[Testdata::Subdir::IntegerMessage],
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials)
+      ).returns(T::Enumerator[Testdata::Subdir::IntegerMessage])
+    end
+    sig do
+      params(
+        request: T::Enumerable[Testdata::Subdir::IntegerMessage],
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials),
+        blk: T.proc.params(response: Testdata::Subdir::IntegerMessage).void
+      ).void
+    end
+    def periodic_max(request, metadata: {}, deadline: nil, parent: nil, credentials: nil, &blk)
+    end
+
+    # Accept a stream of integers, and report the maximum every second
+    # Starts the call like periodic_max(request, return_op: true), without waiting for it.
+    sig do
+      params(
+        request: T::Enumerable[Testdata::Subdir::IntegerMessage],
+        metadata: T::Hash[T.any(String, Symbol), T.any(String, T::Array[String])],
+        deadline: T.nilable(Time),
+        parent: T.nilable(::GRPC::Core::Call),
+        credentials: T.nilable(::GRPC::Core::CallCredentials)
+      ).returns(::GRPC::ActiveCall::Operation)
     end
-    def periodic_max(request)
+    def periodic_max_op(request, metadata: {}, deadline: nil, parent: nil, credentials: nil)
     end
   end
 end
@@ -1,74 +1,14 @@
+# frozen_string_literal: true
 # Generated by the protocol buffer compiler.  DO NOT EDIT!
 # source: subdir/messages.proto
 
 require 'google/protobuf'
 
-Google::Protobuf::DescriptorPool.generated_pool.build do
-  add_file("subdir/messages.proto", :syntax => :proto3) do
-    add_message "testdata.subdir.IntegerMessage" do
-      optional :value, :int32, 1
-    end
-    add_message "testdata.subdir.IntegerMessage.InnerNestedMessage" do
-      optional :value, :float, 1
-    end
-    add_message "testdata.subdir.IntegerMessage.NestedEmpty" do
-    end
-    add_message "testdata.subdir.Empty" do
-    end
-    add_message "testdata.subdir.AllTypes" do
-      optional :double_value, :double, 1
-      optional :float_value, :float, 2
-      optional :int32_value, :int32, 3
-      optional :int64_value, :int64, 4
-      optional :uint32_value, :uint32, 5
-      optional :uint64_value, :uint64, 6
-      optional :sint32_value, :sint32, 7
-      optional :sint64_value, :sint64, 8
-      optional :fixed32_value, :fixed32, 9
-      optional :fixed64_value, :fixed64, 10
-      optional :sfixed32_value, :sfixed32, 11
-      optional :sfixed64_value, :sfixed64, 12
-      optional :bool_value, :bool, 13
-      optional :string_value, :string, 14
-      optional :bytes_value, :bytes, 15
-      optional :enum_value, :enum, 16, "testdata.subdir.AllTypes.Corpus"
-      optional :alias_enum_value, :enum, 17, "testdata.subdir.AllTypes.EnumAllowingAlias"
-      optional :nested_value, :message, 18, "testdata.subdir.IntegerMessage"
-      repeated :repeated_nested_value, :message, 19, "testdata.subdir.IntegerMessage"
-      repeated :repeated_int32_value, :int32, 20
-      repeated :repeated_enum, :enum, 21, "testdata.subdir.AllTypes.Corpus"
-      optional :inner_value, :message, 22, "testdata.subdir.AllTypes.InnerMessage"
-      optional :inner_nested_value, :message, 23, "testdata.subdir.IntegerMessage.InnerNestedMessage"
-      map :string_map_value, :string, :message, 26, "testdata.subdir.IntegerMessage"
-      map :int32_map_value, :int32, :message, 27, "testdata.subdir.IntegerMessage"
-      map :enum_map_value, :string, :enum, 28, "testdata.subdir.AllTypes.Corpus"
-      proto3_optional :optional_bool, :bool, 29
-      oneof :test_oneof do
-        optional :name, :string, 24
-        optional :sub_message, :bool, 25
-      end
-    end
-    add_message "testdata.subdir.AllTypes.InnerMessage" do
-      optional :value, :string, 1
-    end
-    add_enum "testdata.subdir.AllTypes.Corpus" do
-      value :UNIVERSAL, 0
-      value :WEB, 1
-      value :IMAGES, 2
-      value :LOCAL, 3
-      value :NEWS, 4
-      value :PRODUCTS, 5
-      value :VIDEO, 6
-      value :END, 7
-      value :lower, 8
-    end
-    add_enum "testdata.subdir.AllTypes.EnumAllowingAlias" do
-      value :UNKNOWN, 0
-      value :STARTED, 1
-      value :RUNNING, 1
-    end
-  end
-end
+
+descriptor_data = "\n\x15subdir/messages.proto\x12\x0ftestdata.subdir\"S\n\x0eIntegerMessage\x12\r\n\x05value\x18\x01 \x01(\x05\x1a#\n\x12InnerNestedMessage\x12\r\n\x05value\x18\x01 \x01(\x02\x1a\r\n\x0bNestedEmpty\"\x07\n\x05\x45mpty\"\xb9\x0c\n\x08\x41llTypes\x12\x14\n\x0c\x64ouble_value\x18\x01 \x01(\x01\x12\x13\n\x0b\x66loat_value\x18\x02 \x01(\x02\x12\x13\n\x0bint32_value\x18\x03 \x01(\x05\x12\x13\n\x0bint64_value\x18\x04 \x01(\x03\x12\x14\n\x0cuint32_value\x18\x05 \x01(\r\x12\x14\n\x0cuint64_value\x18\x06 \x01(\x04\x12\x14\n\x0csint32_value\x18\x07 \x01(\x11\x12\x14\n\x0csint64_value\x18\x08 \x01(\x12\x12\x15\n\rfixed32_value\x18\t \x01(\x07\x12\x15\n\rfixed64_value\x18\n \x01(\x06\x12\x16\n\x0esfixed32_value\x18\x0b \x01(\x0f\x12\x16\n\x0esfixed64_value\x18\x0c \x01(\x10\x12\x12\n\nbool_value\x18\r \x01(\x08\x12\x14\n\x0cstring_value\x18\x0e \x01(\t\x12\x13\n\x0b\x62ytes_value\x18\x0f \x01(\x0c\x12\x34\n\nenum_value\x18\x10 \x01(\x0e\x32 .testdata.subdir.AllTypes.Corpus\x12\x45\n\x10\x61lias_enum_value\x18\x11 \x01(\x0e\x32+.testdata.subdir.AllTypes.EnumAllowingAlias\x12\x35\n\x0cnested_value\x18\x12 \x01(\x0b\x32\x1f.testdata.subdir.IntegerMessage\x12>\n\x15repeated_nested_value\x18\x13 \x03(\x0b\x32\x1f.testdata.subdir.IntegerMessage\x12\x1c\n\x14repeated_int32_value\x18\x14 \x03(\x05\x12\x37\n\rrepeated_enum\x18\x15 \x03(\x0e\x32 .testdata.subdir.AllTypes.Corpus\x12;\n\x0binner_value\x18\x16 \x01(\x0b\x32&.testdata.subdir.AllTypes.InnerMessage\x12N\n\x12inner_nested_value\x18\x17 \x01(\x0b\x32\x32.testdata.subdir.IntegerMessage.InnerNestedMessage\x12\x0e\n\x04name\x18\x18 \x01(\tH\x00\x12\x15\n\x0bsub_message\x18\x19 \x01(\x08H\x00\x12G\n\x10string_map_value\x18\x1a \x03(\x0b\x32-.testdata.subdir.AllTypes.StringMapValueEntry\x12\x45\n\x0fint32_map_value\x18\x1b \x03(\x0b\x32,.testdata.subdir.AllTypes.Int32MapValueEntry\x12\x43\n\x0e\x65num_map_value\x18\x1c \x03(\x0b\x32+.testdata.subdir.AllTypes.EnumMapValueEntry\x12\x1a\n\roptional_bool\x18\x1d \x01(\x08H\x01\x88\x01\x01\x1a\x1d\n\x0cInnerMessage\x12\r\n\x05value\x18\x01 \x01(\t\x1aV\n\x13StringMapValueEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12.\n\x05value\x18\x02 \x01(\x0b\x32\x1f.testdata.subdir.IntegerMessage:\x02\x38\x01\x1aU\n\x12Int32MapValueEntry\x12\x0b\n\x03key\x18\x01 \x01(\x05\x12.\n\x05value\x18\x02 \x01(\x0b\x32\x1f.testdata.subdir.IntegerMessage:\x02\x38\x01\x1aU\n\x11\x45numMapValueEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12/\n\x05value\x18\x02 \x01(\x0e\x32 .testdata.subdir.AllTypes.Corpus:\x02\x38\x01\"n\n\x06\x43orpus\x12\r\n\tUNIVERSAL\x10\x00\x12\x07\n\x03WEB\x10\x01\x12\n\n\x06IMAGES\x10\x02\x12\t\n\x05LOCAL\x10\x03\x12\x08\n\x04NEWS\x10\x04\x12\x0c\n\x08PRODUCTS\x10\x05\x12\t\n\x05VIDEO\x10\x06\x12\x07\n\x03\x45ND\x10\x07\x12\t\n\x05lower\x10\x08\">\n\x11\x45numAllowingAlias\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0b\n\x07STARTED\x10\x01\x12\x0b\n\x07RUNNING\x10\x01\x1a\x02\x10\x01\x42\x0c\n\ntest_oneofB\x10\n\x0e_optional_boolb\x06proto3"
+
+pool = Google::Protobuf::DescriptorPool.generated_pool
+pool.add_serialized_file(descriptor_data)
 
 module Testdata
   module Subdir
@@ -40,6 +40,20 @@ class Testdata::Subdir::IntegerMessage
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Testdata::Subdir::IntegerMessage) }
   def self.decode(str)
   end
@@ -48,12 +62,24 @@ class Testdata::Subdir::IntegerMessage
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Testdata::Subdir::IntegerMessage) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Testdata::Subdir::IntegerMessage)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Testdata::Subdir::IntegerMessage, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Testdata::Subdir::IntegerMessage,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -80,6 +106,20 @@ class Testdata::Subdir::Empty
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Testdata::Subdir::Empty) }
   def self.decode(str)
   end
@@ -88,12 +128,24 @@ class Testdata::Subdir::Empty
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Testdata::Subdir::Empty) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Testdata::Subdir::Empty)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Testdata::Subdir::Empty, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Testdata::Subdir::Empty,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -107,8 +159,8 @@ class Testdata::Subdir::AllTypes
 
   sig do
     params(
-      double_value: T.nilable(Float),
-      float_value: T.nilable(Float),
+      double_value: T.nilable(T.any(Float, Integer)),
+      float_value: T.nilable(T.any(Float, Integer)),
       int32_value: T.nilable(Integer),
       int64_value: T.nilable(Integer),
       uint32_value: T.nilable(Integer),
@@ -120,21 +172,21 @@ class Testdata::Subdir::AllTypes
       sfixed32_value: T.nilable(Integer),
       sfixed64_value: T.nilable(Integer),
       bool_value: T.nilable(T::Boolean),
-      string_value: T.nilable(String),
+      string_value: T.nilable(T.any(String, Symbol)),
       bytes_value: T.nilable(String),
       enum_value: T.nilable(T.any(Symbol, String, Integer)),
       alias_enum_value: T.nilable(T.any(Symbol, String, Integer)),
-      nested_value: T.nilable(Testdata::Subdir::IntegerMessage),
-      repeated_nested_value: T.nilable(T::Array[T.nilable(Testdata::Subdir::IntegerMessage)]),
+      nested_value: T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped])),
+      repeated_nested_value: T.nilable(T::Array[T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped]))]),
       repeated_int32_value: T.nilable(T::Array[Integer]),
       repeated_enum: T.nilable(T::Array[T.any(Symbol, String, Integer)]),
-      inner_value: T.nilable(Testdata::Subdir::AllTypes::InnerMessage),
-      inner_nested_value: T.nilable(Testdata::Subdir::IntegerMessage::InnerNestedMessage),
-      name: T.nilable(String),
+      inner_value: T.nilable(T.any(Testdata::Subdir::AllTypes::InnerMessage, T::Hash[T.untyped, T.untyped])),
+      inner_nested_value: T.nilable(T.any(Testdata::Subdir::IntegerMessage::InnerNestedMessage, T::Hash[T.untyped, T.untyped])),
+      name: T.nilable(T.any(String, Symbol)),
       sub_message: T.nilable(T::Boolean),
-      string_map_value: T.nilable(T::Hash[String, T.nilable(Testdata::Subdir::IntegerMessage)]),
-      int32_map_value: T.nilable(T::Hash[Integer, T.nilable(Testdata::Subdir::IntegerMessage)]),
-      enum_map_value: T.nilable(T::Hash[String, T.any(Symbol, String, Integer)]),
+      string_map_value: T.nilable(T::Hash[T.any(String, Symbol), T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped]))]),
+      int32_map_value: T.nilable(T::Hash[Integer, T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped]))]),
+      enum_map_value: T.nilable(T::Hash[T.any(String, Symbol), T.any(Symbol, String, Integer)]),
       optional_bool: T.nilable(T::Boolean)
     ).void
   end
@@ -175,7 +227,7 @@ class Testdata::Subdir::AllTypes
   def double_value
   end
 
-  sig { params(value: Float).void }
+  sig { params(value: T.any(Float, Integer)).void }
   def double_value=(value)
   end
 
@@ -187,7 +239,7 @@ class Testdata::Subdir::AllTypes
   def float_value
   end
 
-  sig { params(value: Float).void }
+  sig { params(value: T.any(Float, Integer)).void }
   def float_value=(value)
   end
 
@@ -331,7 +383,7 @@ class Testdata::Subdir::AllTypes
   def string_value
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def string_value=(value)
   end
 
@@ -363,6 +415,10 @@ class Testdata::Subdir::AllTypes
   def clear_enum_value
   end
 
+  sig { returns(Integer) }
+  def enum_value_const
+  end
+
   sig { returns(T.any(Symbol, Integer)) }
   def alias_enum_value
   end
@@ -375,6 +431,10 @@ class Testdata::Subdir::AllTypes
   def clear_alias_enum_value
   end
 
+  sig { returns(Integer) }
+  def alias_enum_value_const
+  end
+
   sig { returns(T.nilable(Testdata::Subdir::IntegerMessage)) }
   def nested_value
   end
@@ -387,6 +447,10 @@ class Testdata::Subdir::AllTypes
   def clear_nested_value
   end
 
+  sig { returns(T::Boolean) }
+  def has_nested_value?
+  end
+
   sig { returns(T::Array[T.nilable(Testdata::Subdir::IntegerMessage)]) }
   def repeated_nested_value
   end
@@ -423,6 +487,10 @@ class Testdata::Subdir::AllTypes
   def clear_repeated_enum
   end
 
+  sig { returns(T::Array[Integer]) }
+  def repeated_enum_const
+  end
+
   sig { returns(T.nilable(Testdata::Subdir::AllTypes::InnerMessage)) }
   def inner_value
   end
@@ -435,6 +503,10 @@ class Testdata::Subdir::AllTypes
   def clear_inner_value
   end
 
+  sig { returns(T::Boolean) }
+  def has_inner_value?
+  end
+
   sig { returns(T.nilable(Testdata::Subdir::IntegerMessage::InnerNestedMessage)) }
   def inner_nested_value
   end
@@ -447,11 +519,15 @@ class Testdata::Subdir::AllTypes
   def clear_inner_nested_value
   end
 
+  sig { returns(T::Boolean) }
+  def has_inner_nested_value?
+  end
+
   sig { returns(String) }
   def name
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def name=(value)
   end
 
@@ -459,6 +535,10 @@ class Testdata::Subdir::AllTypes
   def clear_name
   end
 
+  sig { returns(T::Boolean) }
+  def has_name?
+  end
+
   sig { returns(T::Boolean) }
   def sub_message
   end
@@ -471,6 +551,10 @@ class Testdata::Subdir::AllTypes
   def clear_sub_message
   end
 
+  sig { returns(T::Boolean) }
+  def has_sub_message?
+  end
+
   sig { returns(T::Hash[String, T.nilable(Testdata::Subdir::IntegerMessage)]) }
   def string_map_value
   end
@@ -523,10 +607,19 @@ class Testdata::Subdir::AllTypes
   def has_optional_bool?
   end
 
+  # One of :name, :sub_message
   sig { returns(T.nilable(Symbol)) }
   def test_oneof
   end
 
+  sig { returns(T::Boolean) }
+  def has_test_oneof?
+  end
+
+  sig { void }
+  def clear_test_oneof
+  end
+
   sig { params(field: String).returns(T.untyped) }
   def [](field)
   end
@@ -539,6 +632,20 @@ class Testdata::Subdir::AllTypes
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Testdata::Subdir::AllTypes) }
   def self.decode(str)
   end
@@ -547,12 +654,24 @@ class Testdata::Subdir::AllTypes
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Testdata::Subdir::AllTypes) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Testdata::Subdir::AllTypes)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Testdata::Subdir::AllTypes, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Testdata::Subdir::AllTypes,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -566,7 +685,7 @@ class Testdata::Subdir::IntegerMessage::InnerNestedMessage
 
   sig do
     params(
-      value: T.nilable(Float)
+      value: T.nilable(T.any(Float, Integer))
     ).void
   end
   def initialize(
@@ -578,7 +697,7 @@ class Testdata::Subdir::IntegerMessage::InnerNestedMessage
   def value
   end
 
-  sig { params(value: Float).void }
+  sig { params(value: T.any(Float, Integer)).void }
   def value=(value)
   end
 
@@ -598,6 +717,20 @@ class Testdata::Subdir::IntegerMessage::InnerNestedMessage
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Testdata::Subdir::IntegerMessage::InnerNestedMessage) }
   def self.decode(str)
   end
@@ -606,12 +739,24 @@ class Testdata::Subdir::IntegerMessage::InnerNestedMessage
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Testdata::Subdir::IntegerMessage::InnerNestedMessage) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Testdata::Subdir::IntegerMessage::InnerNestedMessage)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Testdata::Subdir::IntegerMessage::InnerNestedMessage, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Testdata::Subdir::IntegerMessage::InnerNestedMessage,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -638,6 +783,20 @@ class Testdata::Subdir::IntegerMessage::NestedEmpty
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Testdata::Subdir::IntegerMessage::NestedEmpty) }
   def self.decode(str)
   end
@@ -646,12 +805,24 @@ class Testdata::Subdir::IntegerMessage::NestedEmpty
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Testdata::Subdir::IntegerMessage::NestedEmpty) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Testdata::Subdir::IntegerMessage::NestedEmpty)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Testdata::Subdir::IntegerMessage::NestedEmpty, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Testdata::Subdir::IntegerMessage::NestedEmpty,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -665,7 +836,7 @@ class Testdata::Subdir::AllTypes::InnerMessage
 
   sig do
     params(
-      value: T.nilable(String)
+      value: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -677,7 +848,7 @@ class Testdata::Subdir::AllTypes::InnerMessage
   def value
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def value=(value)
   end
 
@@ -697,6 +868,20 @@ class Testdata::Subdir::AllTypes::InnerMessage
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Testdata::Subdir::AllTypes::InnerMessage) }
   def self.decode(str)
   end
@@ -705,12 +890,24 @@ class Testdata::Subdir::AllTypes::InnerMessage
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Testdata::Subdir::AllTypes::InnerMessage) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Testdata::Subdir::AllTypes::InnerMessage)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Testdata::Subdir::AllTypes::InnerMessage, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Testdata::Subdir::AllTypes::InnerMessage,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -5,7 +5,7 @@
 class Example::Lowercase < ::Google::Protobuf::AbstractMessage
   sig do
     params(
-      example_proto_field: T.nilable(String)
+      example_proto_field: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -17,7 +17,7 @@ class Example::Lowercase < ::Google::Protobuf::AbstractMessage
   def example_proto_field
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def example_proto_field=(value)
   end
 
@@ -37,6 +37,20 @@ class Example::Lowercase < ::Google::Protobuf::AbstractMessage
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Example::Lowercase) }
   def self.decode(str)
   end
@@ -45,12 +59,24 @@ class Example::Lowercase < ::Google::Protobuf::AbstractMessage
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Example::Lowercase) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Example::Lowercase)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Example::Lowercase, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Example::Lowercase,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -61,7 +87,7 @@ end
 class Example::Lowercase_with_underscores < ::Google::Protobuf::AbstractMessage
   sig do
     params(
-      example_proto_field: T.nilable(String)
+      example_proto_field: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -73,7 +99,7 @@ class Example::Lowercase_with_underscores < ::Google::Protobuf::AbstractMessage
   def example_proto_field
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def example_proto_field=(value)
   end
 
@@ -93,6 +119,20 @@ class Example::Lowercase_with_underscores < ::Google::Protobuf::AbstractMessage
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Example::Lowercase_with_underscores) }
   def self.decode(str)
   end
@@ -101,12 +141,24 @@ class Example::Lowercase_with_underscores < ::Google::Protobuf::AbstractMessage
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Example::Lowercase_with_underscores) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Example::Lowercase_with_underscores)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Example::Lowercase_with_underscores, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Example::Lowercase_with_underscores,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -37,6 +37,20 @@ class Testdata::Subdir::IntegerMessage < ::Google::Protobuf::AbstractMessage
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Testdata::Subdir::IntegerMessage) }
   def self.decode(str)
   end
@@ -45,12 +59,24 @@ class Testdata::Subdir::IntegerMessage < ::Google::Protobuf::AbstractMessage
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Testdata::Subdir::IntegerMessage) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Testdata::Subdir::IntegerMessage)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Testdata::Subdir::IntegerMessage, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Testdata::Subdir::IntegerMessage,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -74,6 +100,20 @@ class Testdata::Subdir::Empty < ::Google::Protobuf::AbstractMessage
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Testdata::Subdir::Empty) }
   def self.decode(str)
   end
@@ -82,12 +122,24 @@ class Testdata::Subdir::Empty < ::Google::Protobuf::AbstractMessage
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Testdata::Subdir::Empty) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Testdata::Subdir::Empty)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Testdata::Subdir::Empty, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Testdata::Subdir::Empty,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -98,8 +150,8 @@ end
 class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   sig do
     params(
-      double_value: T.nilable(Float),
-      float_value: T.nilable(Float),
+      double_value: T.nilable(T.any(Float, Integer)),
+      float_value: T.nilable(T.any(Float, Integer)),
       int32_value: T.nilable(Integer),
       int64_value: T.nilable(Integer),
       uint32_value: T.nilable(Integer),
@@ -111,21 +163,21 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
       sfixed32_value: T.nilable(Integer),
       sfixed64_value: T.nilable(Integer),
       bool_value: T.nilable(T::Boolean),
-      string_value: T.nilable(String),
+      string_value: T.nilable(T.any(String, Symbol)),
       bytes_value: T.nilable(String),
       enum_value: T.nilable(T.any(Symbol, String, Integer)),
       alias_enum_value: T.nilable(T.any(Symbol, String, Integer)),
-      nested_value: T.nilable(Testdata::Subdir::IntegerMessage),
-      repeated_nested_value: T.nilable(T::Array[T.nilable(Testdata::Subdir::IntegerMessage)]),
+      nested_value: T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped])),
+      repeated_nested_value: T.nilable(T::Array[T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped]))]),
       repeated_int32_value: T.nilable(T::Array[Integer]),
       repeated_enum: T.nilable(T::Array[T.any(Symbol, String, Integer)]),
-      inner_value: T.nilable(Testdata::Subdir::AllTypes::InnerMessage),
-      inner_nested_value: T.nilable(Testdata::Subdir::IntegerMessage::InnerNestedMessage),
-      name: T.nilable(String),
+      inner_value: T.nilable(T.any(Testdata::Subdir::AllTypes::InnerMessage, T::Hash[T.untyped, T.untyped])),
+      inner_nested_value: T.nilable(T.any(Testdata::Subdir::IntegerMessage::InnerNestedMessage, T::Hash[T.untyped, T.untyped])),
+      name: T.nilable(T.any(String, Symbol)),
       sub_message: T.nilable(T::Boolean),
-      string_map_value: T.nilable(T::Hash[String, T.nilable(Testdata::Subdir::IntegerMessage)]),
-      int32_map_value: T.nilable(T::Hash[Integer, T.nilable(Testdata::Subdir::IntegerMessage)]),
-      enum_map_value: T.nilable(T::Hash[String, T.any(Symbol, String, Integer)]),
+      string_map_value: T.nilable(T::Hash[T.any(String, Symbol), T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped]))]),
+      int32_map_value: T.nilable(T::Hash[Integer, T.nilable(T.any(Testdata::Subdir::IntegerMessage, T::Hash[T.untyped, T.untyped]))]),
+      enum_map_value: T.nilable(T::Hash[T.any(String, Symbol), T.any(Symbol, String, Integer)]),
       optional_bool: T.nilable(T::Boolean)
     ).void
   end
@@ -166,7 +218,7 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def double_value
   end
 
-  sig { params(value: Float).void }
+  sig { params(value: T.any(Float, Integer)).void }
   def double_value=(value)
   end
 
@@ -178,7 +230,7 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def float_value
   end
 
-  sig { params(value: Float).void }
+  sig { params(value: T.any(Float, Integer)).void }
   def float_value=(value)
   end
 
@@ -322,7 +374,7 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def string_value
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def string_value=(value)
   end
 
@@ -354,6 +406,10 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_enum_value
   end
 
+  sig { returns(Integer) }
+  def enum_value_const
+  end
+
   sig { returns(T.any(Symbol, Integer)) }
   def alias_enum_value
   end
@@ -366,6 +422,10 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_alias_enum_value
   end
 
+  sig { returns(Integer) }
+  def alias_enum_value_const
+  end
+
   sig { returns(T.nilable(Testdata::Subdir::IntegerMessage)) }
   def nested_value
   end
@@ -378,6 +438,10 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_nested_value
   end
 
+  sig { returns(T::Boolean) }
+  def has_nested_value?
+  end
+
   sig { returns(T::Array[T.nilable(Testdata::Subdir::IntegerMessage)]) }
   def repeated_nested_value
   end
@@ -414,6 +478,10 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_repeated_enum
   end
 
+  sig { returns(T::Array[Integer]) }
+  def repeated_enum_const
+  end
+
   sig { returns(T.nilable(Testdata::Subdir::AllTypes::InnerMessage)) }
   def inner_value
   end
@@ -426,6 +494,10 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_inner_value
   end
 
+  sig { returns(T::Boolean) }
+  def has_inner_value?
+  end
+
   sig { returns(T.nilable(Testdata::Subdir::IntegerMessage::InnerNestedMessage)) }
   def inner_nested_value
   end
@@ -438,11 +510,15 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_inner_nested_value
   end
 
+  sig { returns(T::Boolean) }
+  def has_inner_nested_value?
+  end
+
   sig { returns(String) }
   def name
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def name=(value)
   end
 
@@ -450,6 +526,10 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_name
   end
 
+  sig { returns(T::Boolean) }
+  def has_name?
+  end
+
   sig { returns(T::Boolean) }
   def sub_message
   end
@@ -462,6 +542,10 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def clear_sub_message
   end
 
+  sig { returns(T::Boolean) }
+  def has_sub_message?
+  end
+
   sig { returns(T::Hash[String, T.nilable(Testdata::Subdir::IntegerMessage)]) }
   def string_map_value
   end
@@ -514,10 +598,19 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def has_optional_bool?
   end
 
+  # One of :name, :sub_message
   sig { returns(T.nilable(Symbol)) }
   def test_oneof
   end
 
+  sig { returns(T::Boolean) }
+  def has_test_oneof?
+  end
+
+  sig { void }
+  def clear_test_oneof
+  end
+
   sig { params(field: String).returns(T.untyped) }
   def [](field)
   end
@@ -530,6 +623,20 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Testdata::Subdir::AllTypes) }
   def self.decode(str)
   end
@@ -538,12 +645,24 @@ class Testdata::Subdir::AllTypes < ::Google::Protobuf::AbstractMessage
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Testdata::Subdir::AllTypes) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Testdata::Subdir::AllTypes)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Testdata::Subdir::AllTypes, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Testdata::Subdir::AllTypes,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -554,7 +673,7 @@ end
 class Testdata::Subdir::IntegerMessage::InnerNestedMessage < ::Google::Protobuf::AbstractMessage
   sig do
     params(
-      value: T.nilable(Float)
+      value: T.nilable(T.any(Float, Integer))
     ).void
   end
   def initialize(
@@ -566,7 +685,7 @@ class Testdata::Subdir::IntegerMessage::InnerNestedMessage < ::Google::Protobuf:
   def value
   end
 
-  sig { params(value: Float).void }
+  sig { params(value: T.any(Float, Integer)).void }
   def value=(value)
   end
 
@@ -586,6 +705,20 @@ class Testdata::Subdir::IntegerMessage::InnerNestedMessage < ::Google::Protobuf:
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Testdata::Subdir::IntegerMessage::InnerNestedMessage) }
   def self.decode(str)
   end
@@ -594,12 +727,24 @@ class Testdata::Subdir::IntegerMessage::InnerNestedMessage < ::Google::Protobuf:
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Testdata::Subdir::IntegerMessage::InnerNestedMessage) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Testdata::Subdir::IntegerMessage::InnerNestedMessage)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Testdata::Subdir::IntegerMessage::InnerNestedMessage, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Testdata::Subdir::IntegerMessage::InnerNestedMessage,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -623,6 +768,20 @@ class Testdata::Subdir::IntegerMessage::NestedEmpty < ::Google::Protobuf::Abstra
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Testdata::Subdir::IntegerMessage::NestedEmpty) }
   def self.decode(str)
   end
@@ -631,12 +790,24 @@ class Testdata::Subdir::IntegerMessage::NestedEmpty < ::Google::Protobuf::Abstra
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Testdata::Subdir::IntegerMessage::NestedEmpty) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Testdata::Subdir::IntegerMessage::NestedEmpty)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Testdata::Subdir::IntegerMessage::NestedEmpty, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Testdata::Subdir::IntegerMessage::NestedEmpty,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -647,7 +818,7 @@ end
 class Testdata::Subdir::AllTypes::InnerMessage < ::Google::Protobuf::AbstractMessage
   sig do
     params(
-      value: T.nilable(String)
+      value: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -659,7 +830,7 @@ class Testdata::Subdir::AllTypes::InnerMessage < ::Google::Protobuf::AbstractMes
   def value
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def value=(value)
   end
 
@@ -679,6 +850,20 @@ class Testdata::Subdir::AllTypes::InnerMessage < ::Google::Protobuf::AbstractMes
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Testdata::Subdir::AllTypes::InnerMessage) }
   def self.decode(str)
   end
@@ -687,12 +872,24 @@ class Testdata::Subdir::AllTypes::InnerMessage < ::Google::Protobuf::AbstractMes
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Testdata::Subdir::AllTypes::InnerMessage) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Testdata::Subdir::AllTypes::InnerMessage)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Testdata::Subdir::AllTypes::InnerMessage, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Testdata::Subdir::AllTypes::InnerMessage,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
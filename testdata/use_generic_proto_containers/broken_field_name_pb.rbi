@@ -6,15 +6,26 @@ class Example::Broken_field_name
   include ::Google::Protobuf::MessageExts
   extend ::Google::Protobuf::MessageExts::ClassMethods
 
-  # Constants of the form Constant_1 are invalid. We've declined to type this as a result, taking a hash instead.
-  sig { params(args: T::Hash[T.untyped, T.untyped]).void }
-  def initialize(args); end
+  # Fields of the form Field_1 aren't valid parameter names, so they are taken through _kwargs instead.
+  sig do
+    params(
+      name: T.nilable(T.any(String, Symbol)),
+      kwargs: T.nilable(T::Boolean),
+      _kwargs: T.any(T.nilable(T.any(String, Symbol)), T.nilable(Integer))
+    ).void
+  end
+  def initialize(
+    name: "",
+    kwargs: false,
+    **_kwargs
+  )
+  end
 
   sig { returns(String) }
   def name
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def name=(value)
   end
 
@@ -26,7 +37,7 @@ class Example::Broken_field_name
   def Field_name_1
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def Field_name_1=(value)
   end
 
@@ -34,6 +45,30 @@ class Example::Broken_field_name
   def clear_Field_name_1
   end
 
+  sig { returns(Integer) }
+  def Field_name_2
+  end
+
+  sig { params(value: Integer).void }
+  def Field_name_2=(value)
+  end
+
+  sig { void }
+  def clear_Field_name_2
+  end
+
+  sig { returns(T::Boolean) }
+  def kwargs
+  end
+
+  sig { params(value: T::Boolean).void }
+  def kwargs=(value)
+  end
+
+  sig { void }
+  def clear_kwargs
+  end
+
   sig { params(field: String).returns(T.untyped) }
   def [](field)
   end
@@ -46,6 +81,20 @@ class Example::Broken_field_name
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Example::Broken_field_name) }
   def self.decode(str)
   end
@@ -54,12 +103,24 @@ class Example::Broken_field_name
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Example::Broken_field_name) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Example::Broken_field_name)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Example::Broken_field_name, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Example::Broken_field_name,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -8,7 +8,7 @@ class Package2test::Message2test
 
   sig do
     params(
-      field2test: T.nilable(String)
+      field2test: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -20,7 +20,7 @@ class Package2test::Message2test
   def field2test
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def field2test=(value)
   end
 
@@ -40,6 +40,20 @@ class Package2test::Message2test
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Package2test::Message2test) }
   def self.decode(str)
   end
@@ -48,12 +62,24 @@ class Package2test::Message2test
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Package2test::Message2test) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Package2test::Message2test)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Package2test::Message2test, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Package2test::Message2test,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -9,9 +9,9 @@ class Example::Request
 
   sig do
     params(
-      name: T.nilable(String),
-      nicknames: T.nilable(T::Array[String]),
-      attributes: T.nilable(T::Hash[String, String])
+      name: T.nilable(T.any(String, Symbol)),
+      nicknames: T.nilable(T::Array[T.any(String, Symbol)]),
+      attributes: T.nilable(T::Hash[T.any(String, Symbol), T.any(String, Symbol)])
     ).void
   end
   def initialize(
@@ -27,7 +27,7 @@ class Example::Request
   end
 
   # some description for name field
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def name=(value)
   end
 
@@ -78,6 +78,20 @@ class Example::Request
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Example::Request) }
   def self.decode(str)
   end
@@ -86,12 +100,24 @@ class Example::Request
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Example::Request) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Example::Request)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Example::Request, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Example::Request,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -107,7 +133,7 @@ class Example::Response
 
   sig do
     params(
-      greeting: T.nilable(String)
+      greeting: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -121,7 +147,7 @@ class Example::Response
   end
 
   # some description for greeting field
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def greeting=(value)
   end
 
@@ -142,6 +168,20 @@ class Example::Response
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Example::Response) }
   def self.decode(str)
   end
@@ -150,12 +190,24 @@ class Example::Response
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Example::Response) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Example::Response)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Example::Response, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Example::Response,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
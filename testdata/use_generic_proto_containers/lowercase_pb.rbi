@@ -8,7 +8,7 @@ class Example::Lowercase
 
   sig do
     params(
-      example_proto_field: T.nilable(String)
+      example_proto_field: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -20,7 +20,7 @@ class Example::Lowercase
   def example_proto_field
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def example_proto_field=(value)
   end
 
@@ -40,6 +40,20 @@ class Example::Lowercase
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Example::Lowercase) }
   def self.decode(str)
   end
@@ -48,12 +62,24 @@ class Example::Lowercase
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Example::Lowercase) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Example::Lowercase)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Example::Lowercase, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Example::Lowercase,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }
@@ -67,7 +93,7 @@ class Example::Lowercase_with_underscores
 
   sig do
     params(
-      example_proto_field: T.nilable(String)
+      example_proto_field: T.nilable(T.any(String, Symbol))
     ).void
   end
   def initialize(
@@ -79,7 +105,7 @@ class Example::Lowercase_with_underscores
   def example_proto_field
   end
 
-  sig { params(value: String).void }
+  sig { params(value: T.any(String, Symbol)).void }
   def example_proto_field=(value)
   end
 
@@ -99,6 +125,20 @@ class Example::Lowercase_with_underscores
   def to_h
   end
 
+  sig { returns(String) }
+  def to_proto
+  end
+
+  sig do
+    params(
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def to_json(preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
+  end
+
   sig { params(str: String).returns(Example::Lowercase_with_underscores) }
   def self.decode(str)
   end
@@ -107,12 +147,24 @@ class Example::Lowercase_with_underscores
   def self.encode(msg)
   end
 
-  sig { params(str: String, kw: T.untyped).returns(Example::Lowercase_with_underscores) }
-  def self.decode_json(str, **kw)
+  sig do
+    params(
+      str: String,
+      ignore_unknown_fields: T::Boolean
+    ).returns(Example::Lowercase_with_underscores)
+  end
+  def self.decode_json(str, ignore_unknown_fields: false)
   end
 
-  sig { params(msg: Example::Lowercase_with_underscores, kw: T.untyped).returns(String) }
-  def self.encode_json(msg, **kw)
+  sig do
+    params(
+      msg: Example::Lowercase_with_underscores,
+      preserve_proto_fieldnames: T::Boolean,
+      emit_defaults: T::Boolean,
+      format_enums_as_integers: T::Boolean
+    ).returns(String)
+  end
+  def self.encode_json(msg, preserve_proto_fieldnames: false, emit_defaults: false, format_enums_as_integers: false)
   end
 
   sig { returns(::Google::Protobuf::Descriptor) }